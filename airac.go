@@ -17,10 +17,7 @@
 package airac
 
 import (
-	"fmt"
 	"sort"
-	"strconv"
-	"strings"
 	"time"
 )
 
@@ -45,25 +42,46 @@ type (
 
 // Effective returns the effective date of this AIRAC cycle.
 func (a AIRAC) Effective() time.Time {
-	return _epoch.Add(time.Duration(a) * cycleDuration)
+	return _icao.Effective(a)
 }
 
 // Year returns the year for this AIRAC cycle's identifier.
 func (a AIRAC) Year() int {
-	return a.Effective().Year()
+	return _icao.Year(a)
 }
 
 // Ordinal returns the ordinal for this AIRAC cycle's identifier.
 func (a AIRAC) Ordinal() int {
-	return (a.Effective().YearDay()-1)/28 + 1
+	return _icao.Ordinal(a)
 }
 
 // FromDate returns the AIRAC cycle that occurred at date. A date before the
 // internal epoch (1901-01-10) may return wrong data. The upper limit is year
 // 2192.
+//
+// FromDate strips the monotonic clock reading of date, e.g. of time.Now(), and
+// compares the wall clock instant of date with the effective dates at 00:00
+// UTC, regardless of the location of date. E.g. 2023-12-28 00:30 in Berlin (CET) is
+// 2023-12-27 23:30 UTC and yields cycle 2312, although cycle 2313 becomes
+// effective on 2023-12-28. Use FromDateInLocation to compare wall clock times
+// instead.
 func FromDate(date time.Time) AIRAC {
-	a := date.Sub(_epoch) / cycleDuration
-	return AIRAC(a)
+	return _icao.FromDate(date)
+}
+
+// FromDateInLocation returns the AIRAC cycle that occurred at the wall clock
+// time of date in loc, i.e. as if AIRAC cycles became effective at 00:00 local
+// time in loc. E.g. 2023-12-28 00:30 in Berlin yields cycle 2313 with loc set
+// to Europe/Berlin and cycle 2312 with loc set to time.UTC. Passing
+// date.Location() as loc compares the wall clock time of date as is.
+func FromDateInLocation(date time.Time, loc *time.Location) AIRAC {
+	return _icao.FromDateInLocation(date, loc)
+}
+
+// Current returns the AIRAC cycle that is effective now. Near cycle boundaries
+// systems with skewed clocks may disagree; see CurrentWithin.
+func Current() AIRAC {
+	return FromDate(time.Now())
 }
 
 // FromString returns an AIRAC cycle that matches the identifier <yyoo>, i.e.
@@ -73,69 +91,32 @@ func FromDate(date time.Time) AIRAC {
 // inclusive. AIRAC cycles between "0001" and "6313" are interpreted as AIRAC
 // cycles between the years 2000 and 2063 inclusive.
 func FromString(yyoo string) (AIRAC, error) {
-	year, ordinal, err := parseIdentifier(yyoo)
-	if err != nil {
-		return 0, err
-	}
-
-	lastAiracOfPreviousYear := FromDate(time.Date(year-1, time.December, 31, 0, 0, 0, 0, time.UTC))
-	airac := lastAiracOfPreviousYear + AIRAC(ordinal)
-
-	if airac.Year() != year {
-		return 0, fmt.Errorf("illegal AIRAC id %q", yyoo)
-	}
-
-	return airac, nil
+	return _icao.FromString(yyoo)
 }
 
-func parseIdentifier(yyoo string) (year, ordinal int, err error) {
-	yyoo = strings.TrimSpace(yyoo)
-	if len(yyoo) != 4 {
-		return 0, 0, fmt.Errorf("illegal AIRAC id %q", yyoo)
-	}
-
-	if sign := yyoo[0]; sign == '+' || sign == '-' {
-		return 0, 0, fmt.Errorf("illegal AIRAC id %q", yyoo)
-	}
-
-	yyooInt, err := strconv.Atoi(yyoo)
-	if err != nil {
-		return 0, 0, fmt.Errorf("illegal AIRAC id %q", yyoo)
-	}
-
-	year, ordinal = (yyooInt/100)+1900, yyooInt%100
-	if year <= 1963 {
-		year += 100
-	}
-	return year, ordinal, nil
+// FromBytes returns an AIRAC cycle that matches the identifier <yyoo> like
+// FromString, but avoids converting yyoo to a string, e.g. for fields of
+// fixed-width record formats. It does not allocate unless it returns an error.
+func FromBytes(yyoo []byte) (AIRAC, error) {
+	return _icao.FromBytes(yyoo)
 }
 
 // FromStringMust returns an AIRAC cycle that matches the identifier <yyoo>
 // like FromString, but does not return an error. If there is an error it will
 // panic instead.
 func FromStringMust(yyoo string) AIRAC {
-	airac, err := FromString(yyoo)
-	if err != nil {
-		panic(err)
-	}
-	return airac
+	return _icao.FromStringMust(yyoo)
 }
 
 // String returns a short representation of this AIRAC cycle. "YYOO"
 func (a AIRAC) String() string {
-	return fmt.Sprintf("%02d%02d", a.Year()%100, a.Ordinal())
+	return _icao.String(a)
 }
 
 // LongString returns a verbose representation of this AIRAC cycle.
 // "YYOO (effective: YYYY-MM-DD; expires: YYYY-MM-DD)"
 func (a AIRAC) LongString() string {
-	n := a + 1
-	return fmt.Sprintf("%02d%02d (effective: %s; expires: %s)",
-		a.Year()%100,
-		a.Ordinal(),
-		a.Effective().Format(format),
-		n.Effective().Add(-1).Format(format),
-	)
+	return _icao.LongString(a)
 }
 
 // ByChrono is an []AIRAC wrapper, that satisfies sort.Interface and can be
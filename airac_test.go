@@ -197,6 +197,43 @@ func TestNextPrevious(t *testing.T) {
 	}
 }
 
+func TestCurrent(t *testing.T) {
+	t.Parallel()
+
+	before := FromDate(time.Now())
+	got := Current()
+	after := FromDate(time.Now())
+	if got != before && got != after {
+		t.Errorf("Want %s, got %s", before, got)
+	}
+}
+
+func TestFromDateInLocation(t *testing.T) {
+	t.Parallel()
+
+	cet := time.FixedZone("CET", 3600)
+	date := time.Date(2023, time.December, 28, 0, 30, 0, 0, cet)
+
+	testt := []struct {
+		name string
+		got  AIRAC
+		want string
+	}{
+		{"FromDate", FromDate(date), "2312"},
+		{"in UTC", FromDateInLocation(date, time.UTC), "2312"},
+		{"in CET", FromDateInLocation(date, cet), "2313"},
+		{"in own location", FromDateInLocation(date, date.Location()), "2313"},
+		{"in UTC-1", FromDateInLocation(date.UTC(), time.FixedZone("", -3600)), "2312"},
+		{"UTC in CET", FromDateInLocation(date.UTC(), cet), "2313"},
+	}
+
+	for _, tt := range testt {
+		if tt.got.String() != tt.want {
+			t.Errorf("%s: want %s, got %s", tt.name, tt.want, tt.got)
+		}
+	}
+}
+
 // nolint:funlen
 func TestFromString(t *testing.T) {
 	t.Parallel()
@@ -278,6 +315,31 @@ func TestFromString(t *testing.T) {
 	}
 }
 
+func TestFromBytes(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{"2014", " 7807", "6401", "6313", "", "101", "0000", "1514", "+911", "-101", "160a", "09913"} {
+		want, wantErr := FromString(s)
+		got, err := FromBytes([]byte(s))
+		if (err == nil) != (wantErr == nil) || got != want {
+			t.Errorf("%q: want %s (%v), got %s (%v)", s, want, wantErr, got, err)
+		}
+	}
+}
+
+// TestFromBytesAllocs must not run in parallel with other tests.
+func TestFromBytesAllocs(t *testing.T) {
+	record := []byte("SUSAP KJFKK6AJFK 2313")
+	allocs := testing.AllocsPerRun(100, func() {
+		if _, err := FromBytes(record[17:21]); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("Want no allocations, got %f", allocs)
+	}
+}
+
 func TestFromStringZeroOrdinal(t *testing.T) {
 	t.Parallel()
 
@@ -387,6 +449,18 @@ func BenchmarkFromString(b *testing.B) {
 	runtime.KeepAlive(&r)
 }
 
+func BenchmarkFromBytes(b *testing.B) {
+	r := make([]AIRAC, b.N)
+	id := []byte("2014")
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		r[i], _ = FromBytes(id)
+	}
+
+	runtime.KeepAlive(&r)
+}
+
 func BenchmarkFromDate(b *testing.B) {
 	r := make([]AIRAC, b.N)
 	b.ResetTimer()
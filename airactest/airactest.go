@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package airactest provides utilities for testing code that handles AIRAC
+// cycles: a fake clock, well-known cycles with documented effective dates and
+// assertion helpers.
+package airactest
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jwkohnen/airac"
+)
+
+// Clock is a fake clock for tests. It is safe for concurrent use.
+type Clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewClock returns a Clock set to now.
+func NewClock(now time.Time) *Clock {
+	return &Clock{now: now}
+}
+
+// NewClockAt returns a Clock set to the effective date of cycle a.
+func NewClockAt(a airac.AIRAC) *Clock {
+	return NewClock(a.Effective())
+}
+
+// Now returns the current time of this clock.
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// Set sets this clock to now.
+func (c *Clock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = now
+}
+
+// Advance advances this clock by d.
+func (c *Clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+// AdvanceCycles advances this clock by n cycles of 28 days. A negative n turns
+// the clock back.
+func (c *Clock) AdvanceCycles(n int) {
+	c.Advance(time.Duration(n) * 28 * 24 * time.Hour)
+}
+
+// Cycle returns the AIRAC cycle effective at the current time of this clock.
+func (c *Clock) Cycle() airac.AIRAC {
+	return airac.FromDate(c.Now())
+}
+
+// KnownCycle is an AIRAC cycle whose effective date is documented by an
+// authoritative source.
+type KnownCycle struct {
+	Identifier string
+	Effective  time.Time
+	Source     string
+}
+
+// AIRAC returns the AIRAC cycle of this known cycle.
+func (k KnownCycle) AIRAC() airac.AIRAC {
+	return airac.FromStringMust(k.Identifier)
+}
+
+// KnownCycles returns well-known AIRAC cycles with documented effective dates
+// in chronological order.
+func KnownCycles() []KnownCycle {
+	const (
+		doc8126     = "ICAO DOC 8126, 6th edition (2003)"
+		eurocontrol = "EUROCONTROL AIRAC adherence monitoring"
+		nm          = "EUROCONTROL NM AIRAC dates"
+	)
+	return []KnownCycle{
+		{"9802", date(1998, time.January, 29), doc8126},
+		{"0301", date(2003, time.January, 23), doc8126},
+		{"1201", date(2012, time.January, 12), doc8126},
+		{"1301", date(2013, time.January, 10), eurocontrol},
+		{"1913", date(2019, time.December, 5), eurocontrol},
+		{"2001", date(2020, time.January, 2), eurocontrol},
+		{"2013", date(2020, time.December, 3), eurocontrol},
+		{"2014", date(2020, time.December, 31), eurocontrol},
+		{"2101", date(2021, time.January, 28), nm},
+		{"2301", date(2023, time.January, 26), nm},
+		{"2313", date(2023, time.December, 28), nm},
+	}
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// AssertCycle reports an error if got is not the cycle with identifier want.
+func AssertCycle(tb testing.TB, want string, got airac.AIRAC) bool {
+	tb.Helper()
+
+	if got.String() != want {
+		tb.Errorf("want AIRAC cycle %s, got %s", want, got.LongString())
+		return false
+	}
+	return true
+}
+
+// RequireCycle is like AssertCycle, but stops the test on failure.
+func RequireCycle(tb testing.TB, want string, got airac.AIRAC) {
+	tb.Helper()
+
+	if !AssertCycle(tb, want, got) {
+		tb.FailNow()
+	}
+}
+
+// AssertSameCycle reports an error if want and got are in different AIRAC
+// cycles.
+func AssertSameCycle(tb testing.TB, want, got time.Time) bool {
+	tb.Helper()
+
+	if w, g := airac.FromDate(want), airac.FromDate(got); w != g {
+		tb.Errorf("want %s in AIRAC cycle %s, got %s in cycle %s", want, w.LongString(), got, g.LongString())
+		return false
+	}
+	return true
+}
+
+// RequireSameCycle is like AssertSameCycle, but stops the test on failure.
+func RequireSameCycle(tb testing.TB, want, got time.Time) {
+	tb.Helper()
+
+	if !AssertSameCycle(tb, want, got) {
+		tb.FailNow()
+	}
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airactest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jwkohnen/airac"
+)
+
+func TestClock(t *testing.T) {
+	t.Parallel()
+
+	c := NewClockAt(airac.FromStringMust("2313"))
+	RequireCycle(t, "2313", c.Cycle())
+
+	c.AdvanceCycles(1)
+	AssertCycle(t, "2401", c.Cycle())
+
+	c.Advance(-time.Nanosecond)
+	AssertCycle(t, "2313", c.Cycle())
+
+	now := time.Date(2020, time.December, 31, 12, 0, 0, 0, time.UTC)
+	c.Set(now)
+	if !c.Now().Equal(now) {
+		t.Errorf("Want %s, got %s", now, c.Now())
+	}
+}
+
+func TestKnownCycles(t *testing.T) {
+	t.Parallel()
+
+	var prev airac.AIRAC
+	for i, k := range KnownCycles() {
+		a := k.AIRAC()
+		if !a.Effective().Equal(k.Effective) {
+			t.Errorf("%s (%s): want effective %s, got %s", k.Identifier, k.Source, k.Effective, a.Effective())
+		}
+		if i > 0 && a <= prev {
+			t.Errorf("%s is not in chronological order", k.Identifier)
+		}
+		prev = a
+	}
+}
+
+type recorder struct {
+	testing.TB
+	failed bool
+}
+
+func (r *recorder) Helper()                       {}
+func (r *recorder) Errorf(string, ...interface{}) { r.failed = true }
+func (r *recorder) FailNow()                      { r.failed = true }
+
+func TestAssertions(t *testing.T) {
+	t.Parallel()
+
+	a := airac.FromStringMust("2313")
+
+	testt := []struct {
+		name   string
+		assert func(tb testing.TB)
+		failed bool
+	}{
+		{"same cycle", func(tb testing.TB) { AssertSameCycle(tb, a.Effective(), a.Effective().Add(time.Hour)) }, false},
+		{"different cycle", func(tb testing.TB) { AssertSameCycle(tb, a.Effective(), a.Effective().Add(-time.Hour)) }, true},
+		{"require different cycle", func(tb testing.TB) { RequireSameCycle(tb, a.Effective(), (a + 1).Effective()) }, true},
+		{"cycle", func(tb testing.TB) { AssertCycle(tb, "2313", a) }, false},
+		{"wrong cycle", func(tb testing.TB) { RequireCycle(tb, "2401", a) }, true},
+	}
+
+	for _, tt := range testt {
+		r := &recorder{TB: t}
+		tt.assert(r)
+		if r.failed != tt.failed {
+			t.Errorf("%s: want failed %t, got %t", tt.name, tt.failed, r.failed)
+		}
+	}
+}
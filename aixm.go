@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"time"
+)
+
+const aixmFormat = "2006-01-02T15:04:05Z"
+
+// AIXMDateTime formats t as an xsd:dateTime in UTC as used by begin and end
+// positions of AIXM validTime and featureLifetime time periods, e.g.
+// "2023-12-28T00:00:00Z".
+func AIXMDateTime(t time.Time) string {
+	return t.UTC().Format(aixmFormat)
+}
+
+// AIXMValidTime returns the begin and end positions of the AIXM validTime or
+// featureLifetime of a timeslice valid during this AIRAC cycle, i.e. its
+// effective date and the effective date of the following cycle.
+func (a AIRAC) AIXMValidTime() (begin, end string) {
+	return AIXMDateTime(a.Effective()), AIXMDateTime((a + 1).Effective())
+}
+
+// FromAIXMValidTime returns the range of AIRAC cycles that cover the AIXM
+// validTime or featureLifetime from begin to end, which must be xsd:dateTime
+// values with a time zone, e.g. "2023-12-28T00:00:00Z". The end position is
+// exclusive and must succeed the begin position; open-ended time periods are
+// not supported.
+func FromAIXMValidTime(begin, end string) (Range, error) {
+	if end == "" {
+		return Range{}, fmt.Errorf("open-ended AIXM time period beginning %q", begin)
+	}
+
+	b, err := time.Parse(time.RFC3339, begin)
+	if err != nil {
+		return Range{}, fmt.Errorf("illegal AIXM begin position %q: %w", begin, err)
+	}
+	e, err := time.Parse(time.RFC3339, end)
+	if err != nil {
+		return Range{}, fmt.Errorf("illegal AIXM end position %q: %w", end, err)
+	}
+	if !e.After(b) {
+		return Range{}, fmt.Errorf("AIXM end position %q does not succeed begin position %q", end, begin)
+	}
+
+	return Range{First: FromDate(b), Last: FromDate(e.Add(-1))}, nil
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"testing"
+)
+
+func TestAIXMValidTime(t *testing.T) {
+	t.Parallel()
+
+	begin, end := FromStringMust("2313").AIXMValidTime()
+	if begin != "2023-12-28T00:00:00Z" || end != "2024-01-25T00:00:00Z" {
+		t.Errorf("Want 2023-12-28T00:00:00Z/2024-01-25T00:00:00Z, got %s/%s", begin, end)
+	}
+
+	r, err := FromAIXMValidTime(begin, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := r.String(); got != "2313" {
+		t.Errorf("Want 2313, got %s", got)
+	}
+}
+
+func TestFromAIXMValidTime(t *testing.T) {
+	t.Parallel()
+
+	testt := []struct {
+		begin, end string
+		want       string
+		valid      bool
+	}{
+		{"2023-12-28T00:00:00Z", "2024-02-22T00:00:00Z", "2313-2401", true},
+		{"2023-12-30T12:00:00Z", "2024-01-25T00:00:01Z", "2313-2401", true},
+		{"2023-12-28T01:00:00+02:00", "2023-12-29T00:00:00Z", "2312-2313", true},
+		{"2023-12-28T00:00:00.5Z", "2023-12-29T00:00:00Z", "2313", true},
+		{"2023-12-28T00:00:00Z", "", "", false},
+		{"2023-12-28T00:00:00Z", "2023-12-28T00:00:00Z", "", false},
+		{"2023-12-28", "2024-01-25T00:00:00Z", "", false},
+		{"2023-12-28T00:00:00Z", "2024-01-25T00:00:00", "", false},
+	}
+
+	for _, tt := range testt {
+		got, err := FromAIXMValidTime(tt.begin, tt.end)
+		if tt.valid != (err == nil) {
+			t.Errorf("%s/%s: want valid %t, got error %v", tt.begin, tt.end, tt.valid, err)
+			continue
+		}
+		if tt.valid && got.String() != tt.want {
+			t.Errorf("%s/%s: want %s, got %s", tt.begin, tt.end, tt.want, got)
+		}
+	}
+}
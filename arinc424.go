@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Column positions of the cycle date "YYCC" in ARINC 424 records (1-based,
+// inclusive): columns 36-39 of the HDR01 header record and columns 129-132 of
+// every data record.
+const (
+	arinc424HeaderCycle = 35
+	arinc424RecordCycle = 128
+)
+
+// FromARINC424Header returns the AIRAC cycle that an ARINC 424 file, e.g. a FAA
+// Coded Instrument Flight Procedures (CIFP) file, declares in its first header
+// record ("HDR01").
+func FromARINC424Header(header string) (AIRAC, error) {
+	header = strings.TrimRight(header, "\r\n")
+	if !strings.HasPrefix(header, "HDR01") || len(header) < arinc424HeaderCycle+4 {
+		return 0, fmt.Errorf("illegal ARINC 424 header record %q", header)
+	}
+
+	airac, err := FromString(header[arinc424HeaderCycle : arinc424HeaderCycle+4])
+	if err != nil {
+		return 0, fmt.Errorf("illegal ARINC 424 header record: %w", err)
+	}
+	return airac, nil
+}
+
+// FromARINC424Record returns the AIRAC cycle of an ARINC 424 data record, i.e.
+// the cycle the record was last added or changed in.
+func FromARINC424Record(record string) (AIRAC, error) {
+	record = strings.TrimRight(record, "\r\n")
+	if len(record) < arinc424RecordCycle+4 {
+		return 0, fmt.Errorf("illegal ARINC 424 record %q", record)
+	}
+
+	airac, err := FromString(record[arinc424RecordCycle : arinc424RecordCycle+4])
+	if err != nil {
+		return 0, fmt.Errorf("illegal ARINC 424 record: %w", err)
+	}
+	return airac, nil
+}
+
+// ReadARINC424 reads an ARINC 424 file and returns the AIRAC cycle that its
+// header declares. Every data record is validated against the header, i.e. a
+// record may not have been changed in a cycle later than the declared one.
+func ReadARINC424(r io.Reader) (AIRAC, error) {
+	var (
+		scanner = bufio.NewScanner(r)
+		header  AIRAC
+		line    int
+		found   bool
+	)
+
+	for scanner.Scan() {
+		line++
+		text := scanner.Text()
+		switch {
+		case strings.HasPrefix(text, "HDR01"):
+			a, err := FromARINC424Header(text)
+			if err != nil {
+				return 0, fmt.Errorf("line %d: %w", line, err)
+			}
+			header, found = a, true
+		case strings.HasPrefix(text, "HDR"):
+			continue
+		case !found:
+			return 0, fmt.Errorf("line %d: missing ARINC 424 header record", line)
+		default:
+			a, err := FromARINC424Record(text)
+			if err != nil {
+				return 0, fmt.Errorf("line %d: %w", line, err)
+			}
+			if a > header {
+				return 0, fmt.Errorf("line %d: record of AIRAC cycle %s is newer than declared cycle %s", line, a, header)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+
+	if !found {
+		return 0, fmt.Errorf("missing ARINC 424 header record")
+	}
+	return header, nil
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"strings"
+	"testing"
+)
+
+const testARINC424Header = "HDR01FAACIFP18      001P013203984002210 05-OCT-202209:24:52  U.S.A. DOT FAA AIS"
+
+func testARINC424Record(cycle string) string {
+	return "SUSAP KJFKK6AJFK" + strings.Repeat(" ", 107) + "00001" + cycle
+}
+
+func TestFromARINC424Header(t *testing.T) {
+	t.Parallel()
+
+	got, err := FromARINC424Header(testARINC424Header + "\r\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.String() != "2210" {
+		t.Errorf("Want 2210, got %s", got)
+	}
+
+	for _, invalid := range []string{"", "HDR01", "HDR02FAACIFP18      001P013203984002210", testARINC424Header[:38]} {
+		if got, err := FromARINC424Header(invalid); err == nil {
+			t.Errorf("Header %q yields AIRAC %s, but should have raised an error", invalid, got)
+		}
+	}
+}
+
+func TestFromARINC424Record(t *testing.T) {
+	t.Parallel()
+
+	got, err := FromARINC424Record(testARINC424Record("2207"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.String() != "2207" {
+		t.Errorf("Want 2207, got %s", got)
+	}
+
+	for _, invalid := range []string{"", testARINC424Record("22"), testARINC424Record("2215")} {
+		if got, err := FromARINC424Record(invalid); err == nil {
+			t.Errorf("Record %q yields AIRAC %s, but should have raised an error", invalid, got)
+		}
+	}
+}
+
+func TestReadARINC424(t *testing.T) {
+	t.Parallel()
+
+	valid := strings.Join([]string{
+		testARINC424Header,
+		"HDR02" + strings.Repeat(" ", 127),
+		testARINC424Record("1901"),
+		testARINC424Record("2210"),
+	}, "\r\n")
+
+	got, err := ReadARINC424(strings.NewReader(valid))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.String() != "2210" {
+		t.Errorf("Want 2210, got %s", got)
+	}
+
+	for _, invalid := range []string{
+		"",
+		testARINC424Record("2210"),
+		testARINC424Header + "\n" + testARINC424Record("2211"),
+		testARINC424Header + "\n" + testARINC424Record("    "),
+	} {
+		if got, err := ReadARINC424(strings.NewReader(invalid)); err == nil {
+			t.Errorf("File %q yields AIRAC %s, but should have raised an error", invalid, got)
+		} else {
+			t.Logf("File rightfully yields error: %v", err)
+		}
+	}
+}
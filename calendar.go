@@ -0,0 +1,268 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Calendar describes a schedule of cycles of equal length that become
+// effective at fixed intervals after an epoch, together with the window of
+// years that two-digit identifiers are interpreted in. The zero value is not
+// usable; use NewCalendar or ICAO.
+type Calendar struct {
+	epoch  time.Time
+	period time.Duration
+	pivot  int
+	offset time.Duration
+}
+
+// nolint:gochecknoglobals
+var _icao = Calendar{epoch: _epoch, period: cycleDuration, pivot: 1964}
+
+// NewCalendar returns a calendar whose cycle 0 becomes effective at epoch and
+// every following cycle one period later. Two-digit year identifiers are
+// interpreted as years between pivot and pivot+99 inclusive. The period must
+// be at least one day.
+func NewCalendar(epoch time.Time, period time.Duration, pivot int) (Calendar, error) {
+	if period < 24*time.Hour {
+		return Calendar{}, fmt.Errorf("illegal calendar period %s", period)
+	}
+	if pivot < 0 {
+		return Calendar{}, fmt.Errorf("illegal calendar pivot year %d", pivot)
+	}
+	return Calendar{epoch: epoch.UTC(), period: period, pivot: pivot}, nil
+}
+
+// ICAO returns the calendar of AIRAC cycles as defined by ICAO DOC 8126. The
+// package level functions and the methods of AIRAC use this calendar.
+func ICAO() Calendar {
+	return _icao
+}
+
+// Epoch returns the effective date of cycle 0 of this calendar.
+func (c Calendar) Epoch() time.Time {
+	return c.epoch
+}
+
+// Period returns the duration of one cycle of this calendar.
+func (c Calendar) Period() time.Duration {
+	return c.period
+}
+
+// Pivot returns the first year of the window two-digit identifiers are
+// interpreted in.
+func (c Calendar) Pivot() int {
+	return c.pivot
+}
+
+// Offset returns the activation time offset of this calendar.
+func (c Calendar) Offset() time.Duration {
+	return c.offset
+}
+
+// WithOffset returns a copy of this calendar whose cycles become effective
+// offset later than the nominal effective date, e.g. at a fixed activation
+// hour. Effective, FromDate and Contains reflect the offset, while identifiers
+// and the dates of LongString are those of the nominal effective date. The
+// offset must be between 0 and 24 hours (exclusive).
+func (c Calendar) WithOffset(offset time.Duration) (Calendar, error) {
+	if offset < 0 || offset >= 24*time.Hour {
+		return Calendar{}, fmt.Errorf("illegal calendar offset %s", offset)
+	}
+	c.offset = offset
+	return c, nil
+}
+
+// WithOffsetMust returns a copy of this calendar with an activation time offset
+// like WithOffset, but does not return an error. If there is an error it will
+// panic instead.
+func (c Calendar) WithOffsetMust(offset time.Duration) Calendar {
+	c, err := c.WithOffset(offset)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Effective returns the effective date of cycle a.
+func (c Calendar) Effective(a AIRAC) time.Time {
+	return c.nominal(a).Add(c.offset)
+}
+
+// Contains reports whether date lies within the validity of cycle a, i.e.
+// from its effective date until the effective date of the following cycle
+// (exclusive).
+func (c Calendar) Contains(a AIRAC, date time.Time) bool {
+	return !date.Before(c.Effective(a)) && date.Before(c.Effective(a+1))
+}
+
+// nominal returns the effective date of cycle a without the offset.
+func (c Calendar) nominal(a AIRAC) time.Time {
+	return c.epoch.Add(time.Duration(a) * c.period)
+}
+
+// Year returns the year for the identifier of cycle a.
+func (c Calendar) Year(a AIRAC) int {
+	return c.nominal(a).Year()
+}
+
+// Ordinal returns the ordinal for the identifier of cycle a.
+func (c Calendar) Ordinal(a AIRAC) int {
+	effective := c.nominal(a)
+	newYear := time.Date(effective.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	return int(effective.Sub(newYear)/c.period) + 1
+}
+
+// FromDate returns the cycle that occurred at date. A date before the epoch
+// may return wrong data. The monotonic clock reading of date, if any, is
+// stripped and date is converted to UTC before the calculation, so that only
+// the wall clock instant of date matters.
+func (c Calendar) FromDate(date time.Time) AIRAC {
+	date = date.Round(0).UTC().Add(-c.offset)
+	a := date.Sub(c.epoch) / c.period
+	return AIRAC(a)
+}
+
+// FromDateInLocation returns the cycle that occurred at the wall clock time of
+// date in loc, as if the cycles of this calendar became effective in loc
+// rather than in UTC.
+func (c Calendar) FromDateInLocation(date time.Time, loc *time.Location) AIRAC {
+	wall := date.In(loc)
+	y, m, d := wall.Date()
+	hh, mm, ss := wall.Clock()
+	return c.FromDate(time.Date(y, m, d, hh, mm, ss, wall.Nanosecond(), time.UTC))
+}
+
+// FromString returns the cycle that matches the identifier <yyoo>, i.e. the
+// last two digits of the year and the ordinal, each with leading zeros. The
+// year is interpreted within the window of this calendar's pivot year.
+func (c Calendar) FromString(yyoo string) (AIRAC, error) {
+	year, ordinal, err := c.parseIdentifier(yyoo)
+	if err != nil {
+		return 0, err
+	}
+
+	airac, ok := c.fromYearOrdinal(year, ordinal)
+	if !ok {
+		return 0, fmt.Errorf("illegal AIRAC id %q", yyoo)
+	}
+
+	return airac, nil
+}
+
+// fromYearOrdinal returns the cycle with the given ordinal within year. It
+// reports false if there is no such cycle.
+func (c Calendar) fromYearOrdinal(year, ordinal int) (AIRAC, bool) {
+	if ordinal < 1 {
+		return 0, false
+	}
+
+	newYear := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	first := AIRAC(newYear.Sub(c.epoch) / c.period)
+	if c.nominal(first).Before(newYear) {
+		first++
+	}
+	airac := first + AIRAC(ordinal-1)
+
+	return airac, c.Year(airac) == year
+}
+
+func (c Calendar) parseIdentifier(yyoo string) (year, ordinal int, err error) {
+	yyoo = strings.TrimSpace(yyoo)
+	if len(yyoo) != 4 {
+		return 0, 0, fmt.Errorf("illegal AIRAC id %q", yyoo)
+	}
+
+	if sign := yyoo[0]; sign == '+' || sign == '-' {
+		return 0, 0, fmt.Errorf("illegal AIRAC id %q", yyoo)
+	}
+
+	yyooInt, err := strconv.Atoi(yyoo)
+	if err != nil {
+		return 0, 0, fmt.Errorf("illegal AIRAC id %q", yyoo)
+	}
+
+	year, ordinal = c.yearOrdinal(yyooInt)
+	return year, ordinal, nil
+}
+
+// yearOrdinal splits the identifier in integer form <yyoo> into the year
+// within the window of the pivot year and the ordinal.
+func (c Calendar) yearOrdinal(yyoo int) (year, ordinal int) {
+	year, ordinal = c.pivot-c.pivot%100+yyoo/100, yyoo%100
+	if year < c.pivot {
+		year += 100
+	}
+	return year, ordinal
+}
+
+// FromBytes returns the cycle that matches the identifier <yyoo> like
+// FromString, but avoids converting yyoo to a string. It does not allocate
+// unless it returns an error.
+func (c Calendar) FromBytes(yyoo []byte) (AIRAC, error) {
+	id := bytes.TrimSpace(yyoo)
+	if len(id) != 4 {
+		return 0, fmt.Errorf("illegal AIRAC id %q", id)
+	}
+
+	var yyooInt int
+	for _, b := range id {
+		if !isDigit(b) {
+			return 0, fmt.Errorf("illegal AIRAC id %q", id)
+		}
+		yyooInt = yyooInt*10 + int(b-'0')
+	}
+
+	airac, ok := c.fromYearOrdinal(c.yearOrdinal(yyooInt))
+	if !ok {
+		return 0, fmt.Errorf("illegal AIRAC id %q", id)
+	}
+	return airac, nil
+}
+
+// FromStringMust returns the cycle that matches the identifier <yyoo> like
+// FromString, but does not return an error. If there is an error it will
+// panic instead.
+func (c Calendar) FromStringMust(yyoo string) AIRAC {
+	airac, err := c.FromString(yyoo)
+	if err != nil {
+		panic(err)
+	}
+	return airac
+}
+
+// String returns a short representation of cycle a. "YYOO"
+func (c Calendar) String(a AIRAC) string {
+	return fmt.Sprintf("%02d%02d", c.Year(a)%100, c.Ordinal(a))
+}
+
+// LongString returns a verbose representation of cycle a.
+// "YYOO (effective: YYYY-MM-DD; expires: YYYY-MM-DD)"
+func (c Calendar) LongString(a AIRAC) string {
+	n := a + 1
+	return fmt.Sprintf("%02d%02d (effective: %s; expires: %s)",
+		c.Year(a)%100,
+		c.Ordinal(a),
+		c.nominal(a).Format(format),
+		c.nominal(n).Add(-1).Format(format),
+	)
+}
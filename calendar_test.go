@@ -0,0 +1,171 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCalendarICAO(t *testing.T) {
+	t.Parallel()
+
+	c := ICAO()
+	last := FromStringMust("6313")
+	for a := FromStringMust("6401"); a <= last; a++ {
+		if got, want := c.FromStringMust(a.String()), a; got != want {
+			t.Errorf("Round trip of %s, want %d, got %d", a, want, got)
+		}
+		if got, want := c.FromDate(a.Effective()), a; got != want {
+			t.Errorf("FromDate(%s), want %d, got %d", a.Effective(), want, got)
+		}
+	}
+}
+
+func TestNewCalendar(t *testing.T) {
+	t.Parallel()
+
+	epoch := time.Date(2020, time.January, 2, 0, 0, 0, 0, time.UTC)
+	c, err := NewCalendar(epoch, 14*24*time.Hour, 2000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testt := []struct {
+		id        string
+		effective string
+		valid     bool
+	}{
+		{"2001", "2020-01-02", true},
+		{"2003", "2020-01-30", true},
+		{"2027", "2020-12-31", true},
+		{"2101", "2021-01-14", true},
+		{"2028", "", false},
+		{"2000", "", false},
+		{"9901", "2099-01-01", true},
+	}
+
+	for _, tt := range testt {
+		got, err := c.FromString(tt.id)
+		if !tt.valid {
+			if err == nil {
+				t.Errorf("%q parsed to %s, but should have raised an error", tt.id, c.LongString(got))
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q did not parse: %v", tt.id, err)
+			continue
+		}
+		if eff := c.Effective(got).Format(format); eff != tt.effective {
+			t.Errorf("%q: want effective %s, got %s", tt.id, tt.effective, eff)
+		}
+		if s := c.String(got); s != tt.id {
+			t.Errorf("%q: round trip yields %q", tt.id, s)
+		}
+	}
+}
+
+func TestNewCalendarIllegal(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewCalendar(_epoch, time.Hour, 1964); err == nil {
+		t.Error("Calendar with a period of one hour should have raised an error")
+	}
+	if _, err := NewCalendar(_epoch, cycleDuration, -1); err == nil {
+		t.Error("Calendar with a negative pivot should have raised an error")
+	}
+}
+
+func TestCalendarFromDateNormalization(t *testing.T) {
+	t.Parallel()
+
+	boundary := FromStringMust("2313").Effective()
+	zone := time.FixedZone("", -11*3600)
+
+	for _, d := range []time.Duration{-time.Nanosecond, 0, time.Nanosecond} {
+		date := boundary.Add(d)
+		want := ICAO().FromDate(date)
+		for _, got := range []AIRAC{
+			ICAO().FromDate(date.In(zone)),
+			ICAO().FromDate(date.Local()),
+		} {
+			if got != want {
+				t.Errorf("%s: want %s, got %s", date, want, got)
+			}
+		}
+	}
+
+	now := time.Now()
+	if got, want := FromDate(now), FromDate(now.Round(0).In(zone)); got != want {
+		t.Errorf("%s: want %s, got %s", now, want, got)
+	}
+}
+
+func TestCalendarWithOffset(t *testing.T) {
+	t.Parallel()
+
+	c, err := ICAO().WithOffset(time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := FromStringMust("2313")
+	nominal := a.Effective()
+	if got, want := c.Effective(a), nominal.Add(time.Minute); !got.Equal(want) {
+		t.Errorf("Want effective %s, got %s", want, got)
+	}
+	if got := c.FromDate(nominal); got != a-1 {
+		t.Errorf("At %s want %s, got %s", nominal, a-1, c.String(got))
+	}
+	if got := c.FromDate(nominal.Add(time.Minute)); got != a {
+		t.Errorf("At %s want %s, got %s", nominal.Add(time.Minute), a, c.String(got))
+	}
+	if c.Contains(a, nominal) || !c.Contains(a, nominal.Add(time.Minute)) || !c.Contains(a-1, nominal) {
+		t.Errorf("Unexpected validity of %s at %s", a, nominal)
+	}
+	if got := c.FromStringMust("2313"); got != a {
+		t.Errorf("Want %s, got %s", a, c.String(got))
+	}
+	if got, want := c.LongString(a), a.LongString(); got != want {
+		t.Errorf("Want %s, got %s", want, got)
+	}
+	if c.Offset() != time.Minute || ICAO().Offset() != 0 {
+		t.Errorf("Unexpected offsets %s, %s", c.Offset(), ICAO().Offset())
+	}
+
+	for _, illegal := range []time.Duration{-time.Minute, 24 * time.Hour} {
+		if _, err := ICAO().WithOffset(illegal); err == nil {
+			t.Errorf("Offset %s should have raised an error", illegal)
+		}
+	}
+}
+
+func TestCalendarWithOffsetMust(t *testing.T) {
+	t.Parallel()
+
+	if got := ICAO().WithOffsetMust(time.Minute); got.Offset() != time.Minute {
+		t.Errorf("Want offset %s, got %s", time.Minute, got.Offset())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Illegal offset should have panicked")
+		}
+	}()
+	ICAO().WithOffsetMust(24 * time.Hour)
+}
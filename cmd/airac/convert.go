@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jwkohnen/airac"
+)
+
+// nolint:gochecknoglobals
+var (
+	_convertDate   = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	_convertYYYYOO = regexp.MustCompile(`^(\d{4})[/.-]?(\d{2})$`)
+	_convertDigits = regexp.MustCompile(`^\d+$`)
+)
+
+// convert prints the representations of a cycle given by any of them.
+func convert(e env, args []string) int {
+	var (
+		from string
+		fs   = flag.NewFlagSet("convert", flag.ContinueOnError)
+	)
+	fs.SetOutput(e.stderr)
+	fs.StringVar(&from, "from", "auto", "input `kind`: auto, id, serial, date or yyyyoo")
+	positional, err := parse(fs, args)
+	if err != nil {
+		return exitError
+	}
+	if len(positional) != 1 {
+		return e.fail("convert", errors.New("exactly one value expected"))
+	}
+
+	in := strings.TrimSpace(positional[0])
+	if from == "auto" {
+		from = detectKind(in)
+	}
+
+	a, date, err := convertFrom(from, in)
+	if err != nil {
+		return e.fail("convert", err)
+	}
+
+	w := tabwriter.NewWriter(e.stdout, 0, 0, 1, ' ', 0)
+	fmt.Fprintf(w, "input:\t%s (%s)\n", in, from)
+	fmt.Fprintf(w, "identifier:\t%s\n", a)
+	fmt.Fprintf(w, "yyyyoo:\t%s\n", a.DAFIF())
+	fmt.Fprintf(w, "serial:\t%d\n", a.Serial())
+	fmt.Fprintf(w, "effective:\t%s\n", a.Effective().Format("2006-01-02"))
+	fmt.Fprintf(w, "expires:\t%s\n", a.Expiry().Add(-24*time.Hour).Format("2006-01-02"))
+	fmt.Fprintf(w, "chart cycle:\t%s (%s)\n", a.ChartCycle(), chartPosition(a))
+	fmt.Fprintf(w, "nasr:\t%s\n", a.NASREffective().Format("2006-01-02"))
+	if !date.IsZero() {
+		fmt.Fprintf(w, "moment:\t%s\n", airac.MomentOf(date))
+	}
+	if err := w.Flush(); err != nil {
+		return e.fail("convert", err)
+	}
+	return exitOK
+}
+
+// detectKind returns the kind of input in: date (YYYY-MM-DD), yyyyoo
+// (202313, 2023/13, 2023-13), serial (up to three or five digits) or id
+// (anything else, e.g. 2313 or "AIRAC 23/13").
+func detectKind(in string) string {
+	switch {
+	case _convertDate.MatchString(in):
+		return "date"
+	case _convertYYYYOO.MatchString(in):
+		return "yyyyoo"
+	case _convertDigits.MatchString(in) && len(in) != 4:
+		return "serial"
+	default:
+		return "id"
+	}
+}
+
+// convertFrom returns the cycle denoted by in of the given kind. For dates it
+// also returns the date.
+func convertFrom(kind, in string) (airac.AIRAC, time.Time, error) {
+	switch kind {
+	case "id":
+		a, err := airac.Parse(in, airac.ParseLenient)
+		if err != nil && _convertDigits.MatchString(in) {
+			err = fmt.Errorf("%w (use -from serial for serial numbers)", err)
+		}
+		return a, time.Time{}, err
+	case "serial":
+		serial, err := strconv.ParseUint(in, 10, 16)
+		if err != nil {
+			return 0, time.Time{}, fmt.Errorf("illegal AIRAC serial %q", in)
+		}
+		a, err := airac.FromSerial(uint16(serial))
+		return a, time.Time{}, err
+	case "date":
+		date, err := time.Parse("2006-01-02", in)
+		if err != nil {
+			return 0, time.Time{}, fmt.Errorf("illegal date %q", in)
+		}
+		return airac.FromDate(date), date, nil
+	case "yyyyoo":
+		m := _convertYYYYOO.FindStringSubmatch(in)
+		if m == nil {
+			return 0, time.Time{}, fmt.Errorf("illegal DAFIF cycle %q", in)
+		}
+		a, err := airac.FromDAFIF(m[1] + m[2])
+		return a, time.Time{}, err
+	default:
+		return 0, time.Time{}, fmt.Errorf("illegal input kind %q", kind)
+	}
+}
+
+// chartPosition describes the position of a within its FAA chart cycle.
+func chartPosition(a airac.AIRAC) string {
+	if a.ChartCycle().AIRACs()[0] == a {
+		return "1st of 2 AIRAC cycles"
+	}
+	return "2nd of 2 AIRAC cycles"
+}
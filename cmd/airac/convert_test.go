@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConvert(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+	testt := []struct {
+		args []string
+		code int
+		want []string
+	}{
+		{[]string{"2313"}, exitOK, []string{"(id)", "yyyyoo:      202313", "serial:      1604", "effective:   2023-12-28", "expires:     2024-01-24"}},
+		{[]string{"AIRAC 24/02"}, exitOK, []string{"identifier:  2402", "chart cycle: 2024-01-25 (2nd of 2 AIRAC cycles)"}},
+		{[]string{"1604"}, exitOK, []string{"(id)", "identifier:  1604", "serial:      1503"}},
+		{[]string{"--from", "serial", "1604"}, exitOK, []string{"(serial)", "identifier:  2313"}},
+		{[]string{"1600"}, exitError, nil},
+		{[]string{"160"}, exitOK, []string{"(serial)", "effective:   1913-04-17"}},
+		{[]string{"2024-02-01"}, exitOK, []string{"(date)", "identifier:  2401", "chart cycle: 2024-01-25 (1st of 2 AIRAC cycles)", "moment:      2401 day 8"}},
+		{[]string{"202313"}, exitOK, []string{"(yyyyoo)", "identifier:  2313"}},
+		{[]string{"2023-13"}, exitOK, []string{"(yyyyoo)", "identifier:  2313"}},
+		{[]string{"2023/14"}, exitError, nil},
+		{[]string{"99999"}, exitError, nil},
+		{[]string{"2024-02-30"}, exitError, nil},
+		{[]string{"--from", "chart", "2313"}, exitError, nil},
+		{[]string{}, exitError, nil},
+		{[]string{"2313", "2401"}, exitError, nil},
+	}
+	for _, tt := range testt {
+		code, stdout, stderr := runTest(now, append([]string{"convert"}, tt.args...)...)
+		if code != tt.code {
+			t.Errorf("%v: want exit status %d, got %d:\n%s%s", tt.args, tt.code, code, stdout, stderr)
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(stdout, want) {
+				t.Errorf("%v: want %q in:\n%s", tt.args, want, stdout)
+			}
+		}
+	}
+}
+
+func TestDetectKind(t *testing.T) {
+	t.Parallel()
+
+	testt := []struct {
+		in   string
+		want string
+	}{
+		{"2313", "id"},
+		{"AIRAC 23/13", "id"},
+		{"23-13", "id"},
+		{"1", "serial"},
+		{"16040", "serial"},
+		{"202313", "yyyyoo"},
+		{"2023.13", "yyyyoo"},
+		{"2023-12-28", "date"},
+	}
+	for _, tt := range testt {
+		if got := detectKind(tt.in); got != tt.want {
+			t.Errorf("%q: want %s, got %s", tt.in, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/jwkohnen/airac"
+)
+
+// doctor inspects a navdata directory and checks that the cycle serving now
+// is installed and not stale.
+func doctor(e env, args []string) int {
+	var (
+		policy    airac.StalenessPolicy
+		inspector airac.Inspector
+		at        string
+		fs        = flag.NewFlagSet("doctor", flag.ContinueOnError)
+	)
+	fs.SetOutput(e.stderr)
+	fs.IntVar(&policy.MaxBehind, "max-behind", 0, "number of `cycles` the installed data may lag behind")
+	fs.DurationVar(&policy.Grace, "grace", 0, "`period` after a cycle became effective during which the previous cycle is still current")
+	fs.StringVar(&at, "at", "", "check at `date` YYYY-MM-DD instead of now")
+	fs.Func("template", "additional filename `template` of cycle-stamped files, e.g. navdata_{yyoo}.zip (repeatable)", func(s string) error {
+		t, err := airac.NewFilenameTemplate(s)
+		if err != nil {
+			return err
+		}
+		inspector.Templates = append(inspector.Templates, t)
+		return nil
+	})
+	positional, err := parse(fs, args)
+	if err != nil {
+		return exitError
+	}
+	if len(positional) != 1 {
+		return e.fail("doctor", errors.New("exactly one path expected"))
+	}
+	now, err := parseAt(at, e.now)
+	if err != nil {
+		return e.fail("doctor", err)
+	}
+
+	path := positional[0]
+	if info, err := os.Stat(path); err != nil {
+		return e.fail("doctor", err)
+	} else if !info.IsDir() {
+		return e.fail("doctor", fmt.Errorf("%s is not a directory", path))
+	}
+	inv, err := inspector.Inspect(os.DirFS(path))
+	if err != nil {
+		return e.fail("doctor", err)
+	}
+
+	problems := 0
+	problem := func(format string, args ...interface{}) {
+		problems++
+		fmt.Fprintf(e.stdout, "PROBLEM: "+format+"\n", args...)
+	}
+
+	fmt.Fprintf(e.stdout, "path:      %s\n", path)
+	fmt.Fprintf(e.stdout, "artifacts: %d\n", len(inv.Artifacts))
+	fmt.Fprintf(e.stdout, "installed: %s\n", airac.NewSet(inv.Cycles...))
+	current := airac.FromDate(now.Add(-policy.Grace))
+	fmt.Fprintf(e.stdout, "current:   %s\n", current.LongString())
+
+	for _, a := range inv.Invalid() {
+		problem("%v", a.Err)
+	}
+
+	serving, err := airac.Covering(inv.Cycles, now.Add(-policy.Grace), airac.FallbackPrevious)
+	switch {
+	case len(inv.Cycles) == 0:
+		problem("no cycle-stamped data found")
+	case err != nil:
+		problem("no installed cycle is effective yet; current cycle %s is missing", current)
+	default:
+		status, reason := policy.Evaluate(serving, now)
+		if status != airac.StatusOK {
+			problem("%s", reason)
+		} else {
+			fmt.Fprintf(e.stdout, "serving:   %s\n", reason)
+		}
+	}
+
+	if problems > 0 {
+		fmt.Fprintf(e.stdout, "%d problem(s) found\n", problems)
+		return exitProblem
+	}
+	fmt.Fprintln(e.stdout, "no problems found")
+	return exitOK
+}
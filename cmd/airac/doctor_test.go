@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, data := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestDoctor(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC) // 2401
+	testt := []struct {
+		name  string
+		files map[string]string
+		args  []string
+		code  int
+		want  []string
+	}{
+		{
+			name:  "current",
+			files: map[string]string{"xp/cycle.json": `{"cycle": "2401"}`, "efb/cycle.json": `{"cycle": "2402"}`},
+			code:  exitOK,
+			want:  []string{"installed: 2401-2402", "serving:   dataset 2401 is current", "no problems found"},
+		},
+		{
+			name:  "stale",
+			files: map[string]string{"cycle.json": `{"cycle": "2312"}`},
+			code:  exitProblem,
+			want:  []string{"PROBLEM: dataset 2312 is 2 cycles behind current cycle 2401 (max 0)", "1 problem(s) found"},
+		},
+		{
+			name:  "stale within max",
+			files: map[string]string{"cycle.json": `{"cycle": "2313"}`},
+			args:  []string{"-max-behind", "1"},
+			code:  exitOK,
+		},
+		{
+			name:  "at",
+			files: map[string]string{"cycle.json": `{"cycle": "2313"}`},
+			args:  []string{"-at", "2024-01-24"},
+			code:  exitOK,
+		},
+		{
+			name:  "future only",
+			files: map[string]string{"cycle.json": `{"cycle": "2402"}`},
+			code:  exitProblem,
+			want:  []string{"PROBLEM: no installed cycle is effective yet; current cycle 2401 is missing"},
+		},
+		{
+			name:  "empty",
+			files: map[string]string{"README": "nothing here"},
+			code:  exitProblem,
+			want:  []string{"PROBLEM: no cycle-stamped data found"},
+		},
+		{
+			name:  "invalid",
+			files: map[string]string{"a/cycle.json": `{"cycle": "2401"}`, "b/cycle.json": `{"cycle":`},
+			code:  exitProblem,
+			want:  []string{"PROBLEM: b/cycle.json: illegal cycle.json"},
+		},
+		{
+			name:  "template",
+			files: map[string]string{"navdata_2401.zip": ""},
+			args:  []string{"-template", "navdata_{yyoo}.zip"},
+			code:  exitOK,
+			want:  []string{"artifacts: 1", "installed: 2401"},
+		},
+	}
+	for _, tt := range testt {
+		dir := writeFiles(t, tt.files)
+		code, stdout, stderr := runTest(now, append(append([]string{"doctor"}, tt.args...), dir)...)
+		if code != tt.code {
+			t.Errorf("%s: want exit status %d, got %d:\n%s%s", tt.name, tt.code, code, stdout, stderr)
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(stdout, want) {
+				t.Errorf("%s: want %q in:\n%s", tt.name, want, stdout)
+			}
+		}
+	}
+}
+
+func TestDoctorIllegal(t *testing.T) {
+	t.Parallel()
+
+	dir := writeFiles(t, map[string]string{"file": ""})
+	now := time.Now()
+	for _, args := range [][]string{
+		{"doctor"},
+		{"doctor", dir, dir},
+		{"doctor", filepath.Join(dir, "missing")},
+		{"doctor", filepath.Join(dir, "file")},
+		{"doctor", "-at", "yesterday", dir},
+		{"doctor", "-template", "{nope}", dir},
+		{"doctor", "-nope", dir},
+	} {
+		if code, _, _ := runTest(now, args...); code != exitError {
+			t.Errorf("%v: want exit status %d, got %d", args, exitError, code)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/jwkohnen/airac"
+)
+
+// isCurrent checks whether a cycle is the effective cycle or lags behind it by
+// at most max-lag cycles.
+func isCurrent(e env, args []string) int {
+	var (
+		maxLag int
+		at     string
+		fs     = flag.NewFlagSet("is-current", flag.ContinueOnError)
+	)
+	fs.SetOutput(e.stderr)
+	fs.IntVar(&maxLag, "max-lag", 0, "number of `cycles` the cycle may lag behind the effective cycle")
+	fs.StringVar(&at, "at", "", "check at `date` YYYY-MM-DD instead of now")
+	positional, err := parse(fs, args)
+	if err != nil {
+		return exitError
+	}
+	if len(positional) != 1 {
+		return e.fail("is-current", errors.New("exactly one AIRAC id expected"))
+	}
+	if maxLag < 0 {
+		return e.fail("is-current", fmt.Errorf("illegal max lag %d", maxLag))
+	}
+	now, err := parseAt(at, e.now)
+	if err != nil {
+		return e.fail("is-current", err)
+	}
+	a, err := airac.FromString(positional[0])
+	if err != nil {
+		return e.fail("is-current", err)
+	}
+
+	current := airac.FromDate(now)
+	switch lag := int(current) - int(a); {
+	case lag == 0:
+		fmt.Fprintf(e.stdout, "%s is current\n", a)
+		return exitOK
+	case lag < 0:
+		fmt.Fprintf(e.stdout, "%s is not effective yet; current cycle is %s\n", a, current)
+		return exitProblem
+	case lag <= maxLag:
+		fmt.Fprintf(e.stdout, "%s lags %d behind current cycle %s (max %d)\n", a, lag, current, maxLag)
+		return exitOK
+	default:
+		fmt.Fprintf(e.stdout, "%s lags %d behind current cycle %s (max %d)\n", a, lag, current, maxLag)
+		return exitProblem
+	}
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIsCurrent(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC) // 2401
+	testt := []struct {
+		args []string
+		code int
+		want string
+	}{
+		{[]string{"2401"}, exitOK, "2401 is current"},
+		{[]string{"2313"}, exitProblem, "2313 lags 1 behind current cycle 2401 (max 0)"},
+		{[]string{"2313", "--max-lag", "1"}, exitOK, "2313 lags 1 behind current cycle 2401 (max 1)"},
+		{[]string{"-max-lag=1", "2312"}, exitProblem, "2312 lags 2 behind"},
+		{[]string{"2402"}, exitProblem, "2402 is not effective yet; current cycle is 2401"},
+		{[]string{"2402", "--max-lag", "3"}, exitProblem, "2402 is not effective yet"},
+		{[]string{"2402", "--at", "2024-02-22"}, exitOK, "2402 is current"},
+		{[]string{}, exitError, ""},
+		{[]string{"2401", "2402"}, exitError, ""},
+		{[]string{"2415"}, exitError, ""},
+		{[]string{"2401", "--max-lag", "-1"}, exitError, ""},
+		{[]string{"2401", "--max-lag", "x"}, exitError, ""},
+		{[]string{"2401", "--at", "x"}, exitError, ""},
+	}
+	for _, tt := range testt {
+		code, stdout, stderr := runTest(now, append([]string{"is-current"}, tt.args...)...)
+		if code != tt.code {
+			t.Errorf("%v: want exit status %d, got %d:\n%s%s", tt.args, tt.code, code, stdout, stderr)
+		}
+		if !strings.Contains(stdout, tt.want) {
+			t.Errorf("%v: want %q in:\n%s", tt.args, tt.want, stdout)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+Command airac inspects and checks AIRAC cycles and cycle-stamped data.
+
+Usage:
+
+	airac <command> [flags] [arguments]
+
+The commands are:
+
+	convert <value>     print all representations of a cycle
+	doctor <path>       check the installed cycles of a navdata directory
+	is-current <id>     check whether a cycle is the effective cycle
+
+The exit status is 0 on success, 1 if a check failed and 2 on usage or I/O
+errors.
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+const (
+	exitOK      = 0
+	exitProblem = 1
+	exitError   = 2
+)
+
+// env is the environment a command runs in.
+type env struct {
+	stdout, stderr io.Writer
+	now            time.Time
+}
+
+type command struct {
+	name  string
+	usage string
+	run   func(e env, args []string) int
+}
+
+// nolint:gochecknoglobals
+var commands = []command{
+	{"convert", "convert [--from kind] <id|serial|YYYY-MM-DD|YYYYOO>", convert},
+	{"doctor", "doctor [flags] <path>", doctor},
+	{"is-current", "is-current <id> [--max-lag N] [--at YYYY-MM-DD]", isCurrent},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], env{stdout: os.Stdout, stderr: os.Stderr, now: time.Now()}))
+}
+
+func run(args []string, e env) int {
+	if len(args) == 0 {
+		usage(e.stderr)
+		return exitError
+	}
+	for _, c := range commands {
+		if c.name == args[0] {
+			return c.run(e, args[1:])
+		}
+	}
+	if args[0] == "help" || args[0] == "-h" || args[0] == "-help" || args[0] == "--help" {
+		usage(e.stdout)
+		return exitOK
+	}
+	fmt.Fprintf(e.stderr, "airac: unknown command %q\n", args[0])
+	usage(e.stderr)
+	return exitError
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: airac <command> [flags] [arguments]")
+	fmt.Fprintln(w, "\ncommands:")
+	for _, c := range commands {
+		fmt.Fprintf(w, "\tairac %s\n", c.usage)
+	}
+}
+
+// fail reports err of command name and returns the exit status for errors.
+func (e env) fail(name string, err error) int {
+	fmt.Fprintf(e.stderr, "airac %s: %v\n", name, err)
+	return exitError
+}
+
+// parse parses flags that may be interspersed with the positional arguments
+// and returns the positional arguments.
+func parse(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+// parseAt returns now if at is empty and the date at YYYY-MM-DD otherwise.
+func parseAt(at string, now time.Time) (time.Time, error) {
+	if at == "" {
+		return now, nil
+	}
+	date, err := time.Parse("2006-01-02", at)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("illegal date %q", at)
+	}
+	return date, nil
+}
@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+// runTest runs the command line args at now and returns the exit status and
+// the output.
+func runTest(now time.Time, args ...string) (code int, stdout, stderr string) {
+	var out, errOut bytes.Buffer
+	code = run(args, env{stdout: &out, stderr: &errOut, now: now})
+	return code, out.String(), errOut.String()
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+	if code, _, stderr := runTest(now); code != exitError || !strings.Contains(stderr, "usage:") {
+		t.Errorf("Want usage and exit status %d, got %d:\n%s", exitError, code, stderr)
+	}
+	if code, _, stderr := runTest(now, "nope"); code != exitError || !strings.Contains(stderr, `unknown command "nope"`) {
+		t.Errorf("Want unknown command and exit status %d, got %d:\n%s", exitError, code, stderr)
+	}
+	if code, stdout, _ := runTest(now, "help"); code != exitOK || !strings.Contains(stdout, "airac doctor") {
+		t.Errorf("Want usage and exit status %d, got %d:\n%s", exitOK, code, stdout)
+	}
+}
@@ -0,0 +1,193 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+Command airacgen emits Go source code with a precomputed schedule of AIRAC
+cycles for a range of years, for consumers that prefer compile-time tables over
+runtime calculation.
+
+Usage:
+
+	airacgen [flags]
+
+Typically invoked by go generate:
+
+	//go:generate go run github.com/jwkohnen/airac/cmd/airacgen -from 2024 -to 2030 -pkg navdata -o airac_schedule.go
+
+The repeatable flag -deadline NAME=DAYS adds a field NAME to the generated
+cycle type with the date DAYS days before the effective date, like the
+deadline columns of airac.HTMLSchedule, e.g. -deadline Publication=42.
+
+The generated code does not depend on package airac.
+*/
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jwkohnen/airac"
+)
+
+type config struct {
+	from, to     int
+	pkg, typ, vr string
+	deadlines    deadlines
+	args         []string
+}
+
+// deadlines is a repeatable flag of deadlines NAME=DAYS.
+type deadlines []airac.Deadline
+
+func (d *deadlines) String() string {
+	if d == nil {
+		return ""
+	}
+	var flags []string
+	for _, deadline := range *d {
+		flags = append(flags, fmt.Sprintf("%s=%d", deadline.Name, deadline.Before/(24*time.Hour)))
+	}
+	return strings.Join(flags, ",")
+}
+
+func (d *deadlines) Set(s string) error {
+	name, value, ok := strings.Cut(s, "=")
+	if !ok {
+		return fmt.Errorf("illegal deadline %q; want NAME=DAYS", s)
+	}
+	days, err := strconv.Atoi(value)
+	if err != nil || days < 0 {
+		return fmt.Errorf("illegal deadline %q; want a non-negative number of days", s)
+	}
+	*d = append(*d, airac.Deadline{Name: name, Before: time.Duration(days) * 24 * time.Hour})
+	return nil
+}
+
+// fields are the fields of the generated cycle type before the deadlines.
+// nolint:gochecknoglobals
+var fields = []string{"Identifier", "Serial", "Year", "Ordinal", "Effective", "Expires"}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "airacgen:", err)
+		os.Exit(2)
+	}
+}
+
+func run(args []string, stdout io.Writer) error {
+	var (
+		cfg = config{args: args}
+		out string
+		fs  = flag.NewFlagSet("airacgen", flag.ContinueOnError)
+	)
+	fs.IntVar(&cfg.from, "from", time.Now().Year(), "first `year` of the schedule")
+	fs.IntVar(&cfg.to, "to", time.Now().Year()+1, "last `year` of the schedule")
+	fs.StringVar(&cfg.pkg, "pkg", "main", "package `name` of the generated file")
+	fs.StringVar(&cfg.typ, "type", "Cycle", "`name` of the generated cycle type")
+	fs.StringVar(&cfg.vr, "var", "Cycles", "`name` of the generated schedule variable")
+	fs.StringVar(&out, "o", "", "output `file`; standard output if empty")
+	fs.Var(&cfg.deadlines, "deadline", "add a deadline field `NAME=DAYS` before the effective date (repeatable)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := generate(&buf, cfg); err != nil {
+		return err
+	}
+
+	if out == "" {
+		_, err := stdout.Write(buf.Bytes())
+		return err
+	}
+	return os.WriteFile(out, buf.Bytes(), 0o644)
+}
+
+func generate(w io.Writer, cfg config) error {
+	first := airac.IdentifierRange.First.Year()
+	last := airac.IdentifierRange.Last.Year()
+	if cfg.from < first || cfg.to > last || cfg.from > cfg.to {
+		return fmt.Errorf("illegal year range %d-%d; must be within %d-%d", cfg.from, cfg.to, first, last)
+	}
+	if cfg.pkg == "" || cfg.typ == "" || cfg.vr == "" {
+		return errors.New("package, type and variable names must not be empty")
+	}
+	if err := cfg.deadlines.check(); err != nil {
+		return err
+	}
+
+	var src bytes.Buffer
+	fmt.Fprintf(&src, "// Code generated by airacgen %s; DO NOT EDIT.\n\n", strings.Join(cfg.args, " "))
+	fmt.Fprintf(&src, "package %s\n\n", cfg.pkg)
+	fmt.Fprintf(&src, "// %s is an AIRAC cycle. The dates are formatted \"YYYY-MM-DD\"; Expires is\n", cfg.typ)
+	fmt.Fprintf(&src, "// the last day of the cycle.\n")
+	fmt.Fprintf(&src, "type %s struct {\n\tIdentifier string\n\tSerial uint16\n\tYear int\n\tOrdinal int\n\tEffective string\n\tExpires string\n", cfg.typ)
+	for _, d := range cfg.deadlines {
+		fmt.Fprintf(&src, "\n\t// %s is %d days before Effective.\n\t%s string\n", d.Name, d.Before/(24*time.Hour), d.Name)
+	}
+	fmt.Fprintf(&src, "}\n\n")
+	fmt.Fprintf(&src, "// %s are the AIRAC cycles of the years %d to %d in chronological order.\n", cfg.vr, cfg.from, cfg.to)
+	fmt.Fprintf(&src, "var %s = [...]%s{\n", cfg.vr, cfg.typ)
+
+	a := airac.FromDate(time.Date(cfg.from, time.January, 1, 0, 0, 0, 0, time.UTC))
+	if a.Year() < cfg.from {
+		a++
+	}
+	for ; a.Year() <= cfg.to; a++ {
+		d := a.Details()
+		fmt.Fprintf(&src, "\t{%q, %d, %d, %d, %q, %q", d.Identifier, d.Serial, d.Year, d.Ordinal, d.Effective, d.Expires)
+		for _, deadline := range cfg.deadlines {
+			fmt.Fprintf(&src, ", %q", a.Effective().Add(-deadline.Before).Format("2006-01-02"))
+		}
+		fmt.Fprintf(&src, "},\n")
+	}
+	fmt.Fprintf(&src, "}\n")
+
+	formatted, err := format.Source(src.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(formatted)
+	return err
+}
+
+// check returns an error if the names of the deadlines are not exported Go
+// identifiers or clash with each other or the other fields.
+func (d deadlines) check() error {
+	names := make(map[string]bool)
+	for _, f := range fields {
+		names[f] = true
+	}
+	for _, deadline := range d {
+		if !token.IsIdentifier(deadline.Name) || !token.IsExported(deadline.Name) {
+			return fmt.Errorf("illegal deadline name %q; must be an exported Go identifier", deadline.Name)
+		}
+		if names[deadline.Name] {
+			return fmt.Errorf("duplicate field name %q", deadline.Name)
+		}
+		names[deadline.Name] = true
+	}
+	return nil
+}
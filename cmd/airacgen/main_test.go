@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	if err := run([]string{"-from", "2020", "-to", "2021", "-pkg", "navdata"}, &out); err != nil {
+		t.Fatal(err)
+	}
+	src := out.String()
+
+	if _, err := parser.ParseFile(token.NewFileSet(), "schedule.go", src, 0); err != nil {
+		t.Fatalf("Generated code does not parse: %v\n%s", err, src)
+	}
+
+	for _, want := range []string{
+		"// Code generated by airacgen -from 2020 -to 2021 -pkg navdata; DO NOT EDIT.",
+		"package navdata",
+		`{"2001", 1552, 2020, 1, "2020-01-02", "2020-01-29"},`,
+		`{"2014", 1565, 2020, 14, "2020-12-31", "2021-01-27"},`,
+		`{"2113", 1578, 2021, 13, "2021-12-30", "2022-01-26"},`,
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("Generated code does not contain %q:\n%s", want, src)
+		}
+	}
+	if got := strings.Count(src, "\t{\""); got != 27 {
+		t.Errorf("Want 27 cycles, got %d", got)
+	}
+}
+
+func TestGenerateDeadlines(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	args := []string{"-from", "2024", "-to", "2024", "-deadline", "Publication=42", "-deadline", "Cutoff=20"}
+	if err := run(args, &out); err != nil {
+		t.Fatal(err)
+	}
+	src := out.String()
+
+	if _, err := parser.ParseFile(token.NewFileSet(), "schedule.go", src, 0); err != nil {
+		t.Fatalf("Generated code does not parse: %v\n%s", err, src)
+	}
+
+	for _, want := range []string{
+		"\tPublication string\n",
+		"// Cutoff is 20 days before Effective.",
+		`{"2401", 1605, 2024, 1, "2024-01-25", "2024-02-21", "2023-12-14", "2024-01-05"},`,
+		`{"2413", 1617, 2024, 13, "2024-12-26", "2025-01-22", "2024-11-14", "2024-12-06"},`,
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("Generated code does not contain %q:\n%s", want, src)
+		}
+	}
+	if strings.Index(src, "Publication string") > strings.Index(src, "Cutoff string") {
+		t.Errorf("Deadline fields should be in flag order:\n%s", src)
+	}
+}
+
+func TestGenerateIllegal(t *testing.T) {
+	t.Parallel()
+
+	for _, args := range [][]string{
+		{"-from", "2021", "-to", "2020"},
+		{"-from", "1963", "-to", "2020"},
+		{"-from", "2020", "-to", "2064"},
+		{"-pkg", ""},
+		{"-deadline", "Publication"},
+		{"-deadline", "Publication=x"},
+		{"-deadline", "Publication=-1"},
+		{"-deadline", "publication=42"},
+		{"-deadline", "Pub-lication=42"},
+		{"-deadline", "Effective=42"},
+		{"-deadline", "Cutoff=20", "-deadline", "Cutoff=14"},
+		{"-nope"},
+	} {
+		if err := run(args, new(bytes.Buffer)); err == nil {
+			t.Errorf("Arguments %q should have raised an error", args)
+		}
+	}
+}
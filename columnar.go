@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+)
+
+// Columnar data sets such as Apache Arrow or Parquet store AIRAC cycles best as
+// their serial number in a 16 bit integer column, which is compact, sorts
+// chronologically and is independent of the identifier century window. Null
+// slots of such columns are expected to hold 0 like Arrow builders write them.
+
+// ArrowExtensionName is the name of the Arrow extension type of AIRAC columns
+// as given by ArrowFieldMetadata.
+const ArrowExtensionName = "jwkohnen.airac"
+
+// arrowExtensionMetadata describes the storage of AIRAC columns.
+const arrowExtensionMetadata = `{"encoding":"serial","epoch":"1901-01-10","periodDays":28}`
+
+// ArrowFieldMetadata returns the field metadata of an Arrow or Parquet column
+// of AIRAC serial numbers, i.e. the keys "ARROW:extension:name" and
+// "ARROW:extension:metadata" of an Arrow extension type, so that readers can
+// recognize the column.
+func ArrowFieldMetadata() map[string]string {
+	return map[string]string{
+		"ARROW:extension:name":     ArrowExtensionName,
+		"ARROW:extension:metadata": arrowExtensionMetadata,
+	}
+}
+
+// CheckArrowFieldMetadata returns an error if metadata does not describe a
+// column of AIRAC serial numbers as written by ArrowFieldMetadata.
+func CheckArrowFieldMetadata(metadata map[string]string) error {
+	if name := metadata["ARROW:extension:name"]; name != ArrowExtensionName {
+		return fmt.Errorf("illegal AIRAC column extension name %q", name)
+	}
+	if md := metadata["ARROW:extension:metadata"]; md != arrowExtensionMetadata {
+		return fmt.Errorf("illegal AIRAC column extension metadata %q", md)
+	}
+	return nil
+}
+
+// AppendUint16s appends the serial numbers of cycles to dst and returns the
+// extended column. It returns an error if a cycle is beyond MaxSerial.
+func AppendUint16s(dst []uint16, cycles []AIRAC) ([]uint16, error) {
+	for i, a := range cycles {
+		if uint16(a) > MaxSerial {
+			return dst, fmt.Errorf("index %d: illegal AIRAC serial %d", i, uint16(a))
+		}
+		dst = append(dst, uint16(a))
+	}
+	return dst, nil
+}
+
+// AppendInt16s appends the serial numbers of cycles to dst and returns the
+// extended column, for formats without unsigned integers. It returns an error
+// if a cycle is beyond MaxSerial.
+func AppendInt16s(dst []int16, cycles []AIRAC) ([]int16, error) {
+	for i, a := range cycles {
+		if uint16(a) > MaxSerial {
+			return dst, fmt.Errorf("index %d: illegal AIRAC serial %d", i, uint16(a))
+		}
+		dst = append(dst, int16(a))
+	}
+	return dst, nil
+}
+
+// FromUint16s returns the cycles of a column of serial numbers. It returns an
+// error if a serial number is beyond MaxSerial.
+func FromUint16s(column []uint16) ([]AIRAC, error) {
+	cycles := make([]AIRAC, len(column))
+	for i, serial := range column {
+		if serial > MaxSerial {
+			return nil, fmt.Errorf("index %d: illegal AIRAC serial %d", i, serial)
+		}
+		cycles[i] = AIRAC(serial)
+	}
+	return cycles, nil
+}
+
+// FromInt16s returns the cycles of a column of serial numbers. It returns an
+// error if a serial number is negative or beyond MaxSerial.
+func FromInt16s(column []int16) ([]AIRAC, error) {
+	cycles := make([]AIRAC, len(column))
+	for i, serial := range column {
+		if serial < 0 || uint16(serial) > MaxSerial {
+			return nil, fmt.Errorf("index %d: illegal AIRAC serial %d", i, serial)
+		}
+		cycles[i] = AIRAC(serial)
+	}
+	return cycles, nil
+}
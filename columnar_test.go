@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"testing"
+)
+
+func TestColumnar(t *testing.T) {
+	t.Parallel()
+
+	cycles := []AIRAC{FromStringMust("2313"), FromStringMust("2401"), 0, AIRAC(MaxSerial)}
+
+	u, err := AppendUint16s([]uint16{42}, cycles)
+	if err != nil || len(u) != 5 || u[0] != 42 || u[1] != 1604 || u[4] != MaxSerial {
+		t.Errorf("Unexpected uint16 column %v (%v)", u, err)
+	}
+	i, err := AppendInt16s(nil, cycles)
+	if err != nil || len(i) != 4 || i[0] != 1604 || i[2] != 0 {
+		t.Errorf("Unexpected int16 column %v (%v)", i, err)
+	}
+
+	for _, back := range [][]AIRAC{mustColumn(FromUint16s(u[1:])), mustColumn(FromInt16s(i))} {
+		if len(back) != len(cycles) {
+			t.Fatalf("Want %v, got %v", cycles, back)
+		}
+		for k := range back {
+			if back[k] != cycles[k] {
+				t.Errorf("Want %v, got %v", cycles, back)
+			}
+		}
+	}
+
+	if _, err := AppendUint16s(nil, []AIRAC{AIRAC(MaxSerial) + 1}); err == nil {
+		t.Error("Serial beyond MaxSerial should have raised an error")
+	}
+	if _, err := AppendInt16s(nil, []AIRAC{AIRAC(MaxSerial) + 1}); err == nil {
+		t.Error("Serial beyond MaxSerial should have raised an error")
+	}
+	if _, err := FromUint16s([]uint16{1, MaxSerial + 1}); err == nil {
+		t.Error("Serial beyond MaxSerial should have raised an error")
+	}
+	if _, err := FromInt16s([]int16{-1}); err == nil {
+		t.Error("Negative serial should have raised an error")
+	}
+}
+
+func mustColumn(cycles []AIRAC, err error) []AIRAC {
+	if err != nil {
+		panic(err)
+	}
+	return cycles
+}
+
+func TestArrowFieldMetadata(t *testing.T) {
+	t.Parallel()
+
+	md := ArrowFieldMetadata()
+	if err := CheckArrowFieldMetadata(md); err != nil {
+		t.Error(err)
+	}
+	if md["ARROW:extension:name"] != ArrowExtensionName {
+		t.Errorf("Want extension name %s, got %v", ArrowExtensionName, md)
+	}
+
+	md["ARROW:extension:metadata"] = `{"encoding":"identifier"}`
+	if err := CheckArrowFieldMetadata(md); err == nil {
+		t.Error("Foreign metadata should have raised an error")
+	}
+	if err := CheckArrowFieldMetadata(nil); err == nil {
+		t.Error("Missing metadata should have raised an error")
+	}
+}
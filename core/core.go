@@ -0,0 +1,177 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+Package core implements the AIRAC cycle arithmetic of package airac with
+integer operations only, i.e. without dependencies on fmt, strconv or time and
+without allocations. It is meant for constrained targets, e.g. TinyGo on
+microcontrollers of avionics test rigs.
+
+Cycles are identified by their serial number since the internal epoch
+(1901-01-10), like the values of airac.AIRAC. Dates are either civil dates in
+the proleptic Gregorian calendar or days since the Unix epoch (1970-01-01).
+*/
+package core
+
+const (
+	// CycleDays is the duration of an AIRAC cycle in days.
+	CycleDays = 28
+
+	// EpochUnixDays is the internal epoch (1901-01-10) in days since the
+	// Unix epoch.
+	EpochUnixDays = -25193
+
+	// Pivot is the first year of the window two-digit identifiers are
+	// interpreted in.
+	Pivot = 1964
+)
+
+// FromUnixDays returns the serial of the cycle that is effective on the day
+// days since the Unix epoch. Days before the internal epoch yield 0.
+func FromUnixDays(days int64) uint16 {
+	if days < EpochUnixDays {
+		return 0
+	}
+	return uint16((days - EpochUnixDays) / CycleDays)
+}
+
+// EffectiveUnixDays returns the effective date of cycle serial in days since
+// the Unix epoch.
+func EffectiveUnixDays(serial uint16) int64 {
+	return EpochUnixDays + int64(serial)*CycleDays
+}
+
+// FromCivil returns the serial of the cycle that is effective on the civil date
+// year-month-day.
+func FromCivil(year, month, day int) uint16 {
+	return FromUnixDays(UnixDays(year, month, day))
+}
+
+// EffectiveCivil returns the effective date of cycle serial as a civil date.
+func EffectiveCivil(serial uint16) (year, month, day int) {
+	return Civil(EffectiveUnixDays(serial))
+}
+
+// YearOrdinal returns the year and the ordinal of the identifier of cycle
+// serial.
+func YearOrdinal(serial uint16) (year, ordinal int) {
+	days := EffectiveUnixDays(serial)
+	year, _, _ = Civil(days)
+	return year, int(days-UnixDays(year, 1, 1))/CycleDays + 1
+}
+
+// FromYearOrdinal returns the serial of the cycle with the given ordinal within
+// year. It returns false if there is no such cycle.
+func FromYearOrdinal(year, ordinal int) (uint16, bool) {
+	if ordinal < 1 || year < 1901 {
+		return 0, false
+	}
+
+	newYear := UnixDays(year, 1, 1)
+	first := FromUnixDays(newYear)
+	if EffectiveUnixDays(first) < newYear {
+		first++
+	}
+	serial := first + uint16(ordinal-1)
+
+	if y, _ := YearOrdinal(serial); y != year {
+		return 0, false
+	}
+	return serial, true
+}
+
+// Identifier returns the identifier "YYOO" of cycle serial.
+func Identifier(serial uint16) [4]byte {
+	year, ordinal := YearOrdinal(serial)
+	yy := year % 100
+	return [4]byte{
+		byte('0' + yy/10), byte('0' + yy%10),
+		byte('0' + ordinal/10), byte('0' + ordinal%10),
+	}
+}
+
+// ParseIdentifier returns the serial of the cycle that matches the identifier
+// <yyoo>, i.e. exactly four ASCII digits. Years are interpreted between 1964
+// and 2063 inclusive. It returns false if the identifier is illegal.
+func ParseIdentifier(yyoo []byte) (uint16, bool) {
+	if len(yyoo) != 4 {
+		return 0, false
+	}
+
+	var n int
+	for _, b := range yyoo {
+		if b < '0' || b > '9' {
+			return 0, false
+		}
+		n = n*10 + int(b-'0')
+	}
+
+	year := 1900 + n/100
+	if year < Pivot {
+		year += 100
+	}
+	return FromYearOrdinal(year, n%100)
+}
+
+// UnixDays returns the number of days since the Unix epoch of the civil date
+// year-month-day.
+func UnixDays(year, month, day int) int64 {
+	// http://howardhinnant.github.io/date_algorithms.html#days_from_civil
+	y := int64(year)
+	if month <= 2 {
+		y--
+	}
+	era := floorDiv(y, 400)
+	yoe := y - era*400
+	m := int64(month)
+	if m > 2 {
+		m -= 3
+	} else {
+		m += 9
+	}
+	doy := (153*m+2)/5 + int64(day) - 1
+	doe := yoe*365 + yoe/4 - yoe/100 + doy
+	return era*146097 + doe - 719468
+}
+
+// Civil returns the civil date of the day days since the Unix epoch.
+func Civil(days int64) (year, month, day int) {
+	// http://howardhinnant.github.io/date_algorithms.html#civil_from_days
+	z := days + 719468
+	era := floorDiv(z, 146097)
+	doe := z - era*146097
+	yoe := (doe - doe/1460 + doe/36524 - doe/146096) / 365
+	y := yoe + era*400
+	doy := doe - (365*yoe + yoe/4 - yoe/100)
+	mp := (5*doy + 2) / 153
+	d := doy - (153*mp+2)/5 + 1
+	m := mp + 3
+	if m > 12 {
+		m -= 12
+	}
+	if m <= 2 {
+		y++
+	}
+	return int(y), int(m), int(d)
+}
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jwkohnen/airac"
+)
+
+func TestCivil(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(1800, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for d := start; d.Year() < 2400; d = d.AddDate(0, 0, 1) {
+		days := d.Unix() / 86400
+		if got := UnixDays(d.Year(), int(d.Month()), d.Day()); got != days {
+			t.Fatalf("UnixDays(%s): want %d, got %d", d.Format("2006-01-02"), days, got)
+		}
+		if y, m, dd := Civil(days); y != d.Year() || m != int(d.Month()) || dd != d.Day() {
+			t.Fatalf("Civil(%d): want %s, got %04d-%02d-%02d", days, d.Format("2006-01-02"), y, m, dd)
+		}
+	}
+}
+
+func TestMatchesAIRAC(t *testing.T) {
+	t.Parallel()
+
+	for serial := uint16(0); serial <= airac.MaxSerial; serial++ {
+		a := airac.AIRAC(serial)
+		eff := a.Effective()
+
+		if got := EffectiveUnixDays(serial); got != eff.Unix()/86400 {
+			t.Fatalf("%s: want effective %d, got %d", a, eff.Unix()/86400, got)
+		}
+		if y, o := YearOrdinal(serial); y != a.Year() || o != a.Ordinal() {
+			t.Fatalf("%s: want %d/%d, got %d/%d", a, a.Year(), a.Ordinal(), y, o)
+		}
+		if got := FromCivil(eff.Year(), int(eff.Month()), eff.Day()+27); got != serial {
+			t.Fatalf("%s: last day yields serial %d", a, got)
+		}
+		if id := Identifier(serial); string(id[:]) != a.String() {
+			t.Fatalf("%s: want identifier %s, got %s", a, a, id[:])
+		}
+		if airac.IdentifierRange.Contains(a) {
+			id := Identifier(serial)
+			if got, ok := ParseIdentifier(id[:]); !ok || got != serial {
+				t.Fatalf("%s: ParseIdentifier yields %d (%t)", a, got, ok)
+			}
+		}
+	}
+}
+
+func TestParseIdentifierIllegal(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{"", "101", "0000", "1514", "+911", " 7807", "160a", "09913"} {
+		if got, ok := ParseIdentifier([]byte(s)); ok {
+			t.Errorf("%q yields serial %d, but should be illegal", s, got)
+		}
+	}
+}
+
+// TestAllocs must not run in parallel with other tests.
+func TestAllocs(t *testing.T) {
+	id := []byte("2313")
+	allocs := testing.AllocsPerRun(100, func() {
+		serial, _ := ParseIdentifier(id)
+		_ = Identifier(FromCivil(EffectiveCivil(serial)))
+	})
+	if allocs != 0 {
+		t.Errorf("Want no allocations, got %f", allocs)
+	}
+}
@@ -0,0 +1,179 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Range is a contiguous range of AIRAC cycles from First to Last inclusive.
+type Range struct {
+	First AIRAC
+	Last  AIRAC
+}
+
+// Contains reports whether cycle a lies within this range.
+func (r Range) Contains(a AIRAC) bool {
+	return r.First <= a && a <= r.Last
+}
+
+// Len returns the number of cycles in this range. It returns 0 if Last
+// precedes First.
+func (r Range) Len() int {
+	if r.Last < r.First {
+		return 0
+	}
+	return int(r.Last-r.First) + 1
+}
+
+// String returns a short representation of this range. "YYOO-YYOO" or "YYOO"
+// if the range consists of a single cycle.
+func (r Range) String() string {
+	if r.First == r.Last {
+		return r.First.String()
+	}
+	return r.First.String() + "-" + r.Last.String()
+}
+
+// Set returns a new set of the cycles in this range.
+func (r Range) Set() *Set {
+	s := new(Set)
+	for a := r.First; r.Contains(a); a++ {
+		s.Add(a)
+		if a == r.Last {
+			break
+		}
+	}
+	return s
+}
+
+// Runs returns the maximal ranges of consecutive cycles of this set in
+// chronological order.
+func (s *Set) Runs() []Range {
+	var runs []Range
+	s.Each(func(a AIRAC) bool {
+		if n := len(runs); n > 0 && runs[n-1].Last+1 == a {
+			runs[n-1].Last = a
+		} else {
+			runs = append(runs, Range{First: a, Last: a})
+		}
+		return true
+	})
+	return runs
+}
+
+// Gaps returns the maximal ranges of consecutive cycles within r that are
+// missing from this set in chronological order.
+func (s *Set) Gaps(r Range) []Range {
+	var gaps []Range
+	for a := r.First; r.Contains(a); a++ {
+		if !s.Contains(a) {
+			if n := len(gaps); n > 0 && gaps[n-1].Last+1 == a {
+				gaps[n-1].Last = a
+			} else {
+				gaps = append(gaps, Range{First: a, Last: a})
+			}
+		}
+		if a == r.Last {
+			break
+		}
+	}
+	return gaps
+}
+
+// Diff compares the coverages a and b. It returns the ranges of cycles that
+// are present in a but missing in b, and those present in b but missing in a.
+func Diff(a, b *Set) (onlyA, onlyB []Range) {
+	return a.Difference(b).Runs(), b.Difference(a).Runs()
+}
+
+// RangeFromString returns the range that matches the representation
+// "YYOO-YYOO" or "YYOO" of Range.String. Surrounding white space is not
+// allowed and the first cycle must not succeed the last cycle.
+func RangeFromString(s string) (Range, error) {
+	first, last := s, s
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		first, last = s[:i], s[i+1:]
+	}
+
+	var (
+		r   Range
+		err error
+	)
+	if r.First, err = Parse(first, ParseStrict); err != nil {
+		return Range{}, fmt.Errorf("illegal AIRAC range %q: %w", s, err)
+	}
+	if r.Last, err = Parse(last, ParseStrict); err != nil {
+		return Range{}, fmt.Errorf("illegal AIRAC range %q: %w", s, err)
+	}
+	if r.Last < r.First {
+		return Range{}, fmt.Errorf("illegal AIRAC range %q: %s succeeds %s", s, r.First, r.Last)
+	}
+	return r, nil
+}
+
+// String returns the run-length representation of this set, i.e. the
+// comma-separated ranges of consecutive cycles, e.g. "2301-2313,2402,2405-2407".
+func (s *Set) String() string {
+	runs := s.Runs()
+	parts := make([]string, len(runs))
+	for i, r := range runs {
+		parts[i] = r.String()
+	}
+	return strings.Join(parts, ",")
+}
+
+// SetFromString returns the set that matches the run-length representation of
+// Set.String. The ranges must be in chronological order and must not overlap.
+// The empty string denotes the empty set.
+func SetFromString(s string) (*Set, error) {
+	set := new(Set)
+	if s == "" {
+		return set, nil
+	}
+
+	var prev Range
+	for i, part := range strings.Split(s, ",") {
+		r, err := RangeFromString(part)
+		if err != nil {
+			return nil, fmt.Errorf("illegal AIRAC set %q: %w", s, err)
+		}
+		if i > 0 && r.First <= prev.Last {
+			return nil, fmt.Errorf("illegal AIRAC set %q: %s does not succeed %s", s, r, prev)
+		}
+		set = set.Union(r.Set())
+		prev = r
+	}
+	return set, nil
+}
+
+// MarshalText implements encoding.TextMarshaler with the run-length
+// representation of Set.String.
+func (s *Set) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler like SetFromString.
+func (s *Set) UnmarshalText(text []byte) error {
+	set, err := SetFromString(string(text))
+	if err != nil {
+		return err
+	}
+	s.words = set.words
+	return nil
+}
@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRange(t *testing.T) {
+	t.Parallel()
+
+	r := Range{First: FromStringMust("2212"), Last: FromStringMust("2302")}
+	if got, want := r.Len(), 4; got != want {
+		t.Errorf("Want %d cycles, got %d", want, got)
+	}
+	if got, want := r.String(), "2212-2302"; got != want {
+		t.Errorf("Want %s, got %s", want, got)
+	}
+	if got, want := fmt.Sprint(r.Set().Cycles()), "[2212 2213 2301 2302]"; got != want {
+		t.Errorf("Want %s, got %s", want, got)
+	}
+	if !r.Contains(FromStringMust("2213")) || r.Contains(FromStringMust("2303")) {
+		t.Errorf("Unexpected containment in %s", r)
+	}
+
+	single := Range{First: r.First, Last: r.First}
+	if got, want := single.String(), "2212"; got != want {
+		t.Errorf("Want %s, got %s", want, got)
+	}
+	if got := (Range{First: r.Last, Last: r.First}).Len(); got != 0 {
+		t.Errorf("Want empty range, got %d cycles", got)
+	}
+
+	full := Range{First: 65534, Last: 65535}
+	if got := full.Set().Len(); got != 2 {
+		t.Errorf("Want 2 cycles at the upper limit, got %d", got)
+	}
+}
+
+func TestGaps(t *testing.T) {
+	t.Parallel()
+
+	r := Range{First: FromStringMust("2205"), Last: FromStringMust("2213")}
+	s := r.Set()
+	s.Remove(FromStringMust("2209"))
+	s.Add(FromStringMust("2301"))
+
+	if got, want := fmt.Sprint(s.Gaps(r)), "[2209]"; got != want {
+		t.Errorf("Want gaps %s, got %s", want, got)
+	}
+	if got, want := fmt.Sprint(s.Runs()), "[2205-2208 2210-2301]"; got != want {
+		t.Errorf("Want runs %s, got %s", want, got)
+	}
+
+	wide := Range{First: FromStringMust("2201"), Last: FromStringMust("2303")}
+	if got, want := fmt.Sprint(s.Gaps(wide)), "[2201-2204 2209 2302-2303]"; got != want {
+		t.Errorf("Want gaps %s, got %s", want, got)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	a := Range{First: FromStringMust("2301"), Last: FromStringMust("2306")}.Set()
+	b := Range{First: FromStringMust("2303"), Last: FromStringMust("2308")}.Set()
+	b.Remove(FromStringMust("2304"))
+
+	onlyA, onlyB := Diff(a, b)
+	if got, want := fmt.Sprint(onlyA), "[2301-2302 2304]"; got != want {
+		t.Errorf("Want only in A %s, got %s", want, got)
+	}
+	if got, want := fmt.Sprint(onlyB), "[2307-2308]"; got != want {
+		t.Errorf("Want only in B %s, got %s", want, got)
+	}
+}
+
+func TestSetString(t *testing.T) {
+	t.Parallel()
+
+	testt := []struct {
+		s     string
+		valid bool
+	}{
+		{"2301-2313,2402,2405-2407", true},
+		{"2301", true},
+		{"", true},
+		{"2212-2301", true},
+		{"2301-2313,2313", false},
+		{"2402,2301", false},
+		{"2305-2301", false},
+		{"2301,", false},
+		{"2301, 2302", false},
+		{" 2301", false},
+		{"2301-", false},
+		{"2301-2302-2303", false},
+		{"2314", false},
+	}
+
+	for _, tt := range testt {
+		got, err := SetFromString(tt.s)
+		if tt.valid != (err == nil) {
+			t.Errorf("%q: want valid %t, got error %v", tt.s, tt.valid, err)
+			continue
+		}
+		if tt.valid && got.String() != tt.s {
+			t.Errorf("%q: round trip yields %q", tt.s, got.String())
+		}
+	}
+}
+
+func TestSetText(t *testing.T) {
+	t.Parallel()
+
+	want := NewSet(FromStringMust("2301"), FromStringMust("2302"), FromStringMust("2305"))
+	text, err := want.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(text) != "2301-2302,2305" {
+		t.Errorf("Want 2301-2302,2305, got %s", text)
+	}
+
+	var got Set
+	if err := got.UnmarshalText(text); err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(want) {
+		t.Errorf("Want %s, got %s", want, &got)
+	}
+
+	if err := got.UnmarshalText([]byte("nope")); err == nil {
+		t.Error("Unmarshaling garbage should have raised an error")
+	}
+}
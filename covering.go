@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Fallback selects which cycle Covering picks if the cycle that is effective
+// at a point in time is not available.
+type Fallback int
+
+const (
+	// FallbackPrevious picks the newest available cycle that precedes the
+	// effective cycle, i.e. the data that was last known at that time.
+	FallbackPrevious Fallback = iota
+
+	// FallbackError picks no cycle and returns an error instead.
+	FallbackError
+
+	// FallbackNearest picks the available cycle whose validity is nearest in
+	// time, preferring the preceding cycle if both are equally near.
+	FallbackNearest
+)
+
+// Covering returns the cycle of available that should serve date. available
+// must be sorted in ascending order, e.g. by sort.Sort(ByChrono(available)).
+// If the cycle that is effective at date is available it is returned,
+// otherwise a cycle is picked according to fallback. Covering returns an error
+// if no cycle qualifies.
+func Covering(available []AIRAC, date time.Time, fallback Fallback) (AIRAC, error) {
+	want := FromDate(date)
+	i := sort.Search(len(available), func(i int) bool { return available[i] >= want })
+	if i < len(available) && available[i] == want {
+		return want, nil
+	}
+
+	switch fallback {
+	case FallbackPrevious:
+		if i > 0 {
+			return available[i-1], nil
+		}
+	case FallbackError:
+	case FallbackNearest:
+		switch {
+		case i == 0 && i == len(available):
+		case i == 0:
+			return available[i], nil
+		case i == len(available):
+			return available[i-1], nil
+		default:
+			prev, next := available[i-1], available[i]
+			if date.Sub((prev + 1).Effective()) <= next.Effective().Sub(date) {
+				return prev, nil
+			}
+			return next, nil
+		}
+	default:
+		return 0, fmt.Errorf("illegal fallback %d", int(fallback))
+	}
+
+	return 0, fmt.Errorf("no available AIRAC cycle covers %s (effective: %s)", date.Format(time.RFC3339), want)
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCovering(t *testing.T) {
+	t.Parallel()
+
+	available := []AIRAC{FromStringMust("2301"), FromStringMust("2302"), FromStringMust("2306")}
+	testt := []struct {
+		date     string
+		fallback Fallback
+		want     string
+		ok       bool
+	}{
+		{"2023-02-01", FallbackError, "2301", true},
+		{"2023-02-23", FallbackError, "2302", true},
+		{"2023-03-23", FallbackError, "", false},
+		{"2023-03-23", FallbackPrevious, "2302", true},
+		{"2023-03-23", FallbackNearest, "2302", true},
+		{"2023-05-18", FallbackNearest, "2306", true},
+		{"2023-05-04", FallbackNearest, "2302", true}, // equally near
+		{"2023-05-05", FallbackNearest, "2306", true},
+		{"2023-01-01", FallbackPrevious, "", false},
+		{"2023-01-01", FallbackNearest, "2301", true},
+		{"2024-01-01", FallbackPrevious, "2306", true},
+		{"2024-01-01", FallbackNearest, "2306", true},
+		{"2023-02-01", Fallback(-1), "2301", true},
+		{"2023-03-23", Fallback(-1), "", false},
+	}
+	for _, tt := range testt {
+		date, err := time.Parse("2006-01-02", tt.date)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := Covering(available, date, tt.fallback)
+		if !tt.ok {
+			if err == nil {
+				t.Errorf("%s (%d): got %s, but should have raised an error", tt.date, tt.fallback, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s (%d): %v", tt.date, tt.fallback, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("%s (%d): want %s, got %s", tt.date, tt.fallback, tt.want, got)
+		}
+	}
+}
+
+func TestCoveringEmpty(t *testing.T) {
+	t.Parallel()
+
+	for _, fallback := range []Fallback{FallbackPrevious, FallbackError, FallbackNearest} {
+		if got, err := Covering(nil, time.Now(), fallback); err == nil {
+			t.Errorf("%d: got %s, but should have raised an error", fallback, got)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// CurrentWithin returns the cycle that is effective now like Current, but
+// takes a clock skew of up to ±skew between systems into account: within skew
+// of a cycle boundary it reports the cycles before and after the boundary as
+// ambiguous, so that the caller can apply its own tie-breaking. Otherwise
+// earlier and later are both the current cycle.
+func CurrentWithin(skew time.Duration) (earlier, later AIRAC, ambiguous bool) {
+	return FromDateWithin(time.Now(), skew)
+}
+
+// FromDateWithin returns the cycle that is effective at date like
+// CurrentWithin.
+func FromDateWithin(date time.Time, skew time.Duration) (earlier, later AIRAC, ambiguous bool) {
+	if skew < 0 {
+		skew = -skew
+	}
+	earlier, later = FromDate(date.Add(-skew)), FromDate(date.Add(skew))
+	return earlier, later, earlier != later
+}
+
+// CurrentCache caches the current AIRAC cycle for hot paths, e.g. to inject it
+// into every response. The cycle is kept in an atomic value, so reading it
+// neither locks nor recomputes it. The zero value is ready to use. It is safe
+// for concurrent use.
+//
+// Get refreshes the cycle lazily once it has expired. Alternatively a
+// scheduler calls Refresh at the boundaries returned by Expires and readers
+// call Load, which does not even read the clock.
+type CurrentCache struct {
+	state atomic.Pointer[currentState]
+	now   func() time.Time
+}
+
+type currentState struct {
+	airac   AIRAC
+	expires time.Time
+}
+
+// Get returns the cycle that is effective now, refreshing the cached cycle if
+// it has expired.
+func (c *CurrentCache) Get() AIRAC {
+	now := c.clock()
+	if s := c.state.Load(); s != nil && now.Before(s.expires) {
+		return s.airac
+	}
+	return c.Refresh(now)
+}
+
+// Load returns the cached cycle without checking whether it has expired. If
+// there is no cached cycle yet, it is refreshed like by Get.
+func (c *CurrentCache) Load() AIRAC {
+	if s := c.state.Load(); s != nil {
+		return s.airac
+	}
+	return c.Refresh(c.clock())
+}
+
+// Refresh caches and returns the cycle that is effective at now.
+func (c *CurrentCache) Refresh(now time.Time) AIRAC {
+	a := FromDate(now)
+	c.state.Store(&currentState{airac: a, expires: (a + 1).Effective()})
+	return a
+}
+
+// Expires returns the effective date of the cycle following the cached cycle,
+// i.e. the time to refresh at. It returns the zero time if there is no cached
+// cycle yet.
+func (c *CurrentCache) Expires() time.Time {
+	if s := c.state.Load(); s != nil {
+		return s.expires
+	}
+	return time.Time{}
+}
+
+func (c *CurrentCache) clock() time.Time {
+	if c.now != nil {
+		return c.now()
+	}
+	return time.Now()
+}
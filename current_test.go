@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCurrentCache(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.February, 21, 23, 59, 59, 0, time.UTC)
+	c := &CurrentCache{now: func() time.Time { return now }}
+
+	if !c.Expires().IsZero() {
+		t.Errorf("Want zero expiry, got %s", c.Expires())
+	}
+	if got := c.Get(); got.String() != "2401" {
+		t.Errorf("Want 2401, got %s", got)
+	}
+	if want := FromStringMust("2402").Effective(); !c.Expires().Equal(want) {
+		t.Errorf("Want expiry %s, got %s", want, c.Expires())
+	}
+
+	now = now.Add(time.Second)
+	if got := c.Load(); got.String() != "2401" {
+		t.Errorf("Load should not refresh: want 2401, got %s", got)
+	}
+	if got := c.Get(); got.String() != "2402" {
+		t.Errorf("Get should refresh: want 2402, got %s", got)
+	}
+
+	if got := c.Refresh(now.AddDate(0, 0, 28)); got.String() != "2403" || c.Load() != got {
+		t.Errorf("Want 2403, got %s", got)
+	}
+}
+
+func TestCurrentCacheZero(t *testing.T) {
+	t.Parallel()
+
+	var c CurrentCache
+	if got, want := c.Load(), Current(); got != want {
+		t.Errorf("Want %s, got %s", want, got)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_ = c.Get()
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkCurrentCacheLoad(b *testing.B) {
+	var c CurrentCache
+	c.Refresh(time.Now())
+	for i := 0; i < b.N; i++ {
+		_ = c.Load()
+	}
+}
+
+func TestFromDateWithin(t *testing.T) {
+	t.Parallel()
+
+	boundary := FromStringMust("2401").Effective()
+	testt := []struct {
+		date      time.Time
+		skew      time.Duration
+		earlier   string
+		later     string
+		ambiguous bool
+	}{
+		{boundary.Add(-10 * time.Minute), 5 * time.Minute, "2313", "2313", false},
+		{boundary.Add(-5 * time.Minute), 5 * time.Minute, "2313", "2401", true},
+		{boundary, 5 * time.Minute, "2313", "2401", true},
+		{boundary.Add(4 * time.Minute), 5 * time.Minute, "2313", "2401", true},
+		{boundary.Add(5 * time.Minute), 5 * time.Minute, "2401", "2401", false},
+		{boundary.Add(time.Minute), -5 * time.Minute, "2313", "2401", true},
+		{boundary, 0, "2401", "2401", false},
+	}
+	for _, tt := range testt {
+		earlier, later, ambiguous := FromDateWithin(tt.date, tt.skew)
+		if earlier.String() != tt.earlier || later.String() != tt.later || ambiguous != tt.ambiguous {
+			t.Errorf("%s ±%s: want %s, %s, %t, got %s, %s, %t",
+				tt.date, tt.skew, tt.earlier, tt.later, tt.ambiguous, earlier, later, ambiguous)
+		}
+	}
+
+	if earlier, later, _ := CurrentWithin(time.Minute); later-earlier > 1 {
+		t.Errorf("Want adjacent candidates, got %s and %s", earlier, later)
+	}
+}
@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"sort"
+	"time"
+)
+
+// CycleID is implemented by cycle types like AIRAC and ChartCycle, so that
+// code can be written once against all of them with the generic helpers of
+// this package, e.g. SortCycles or CycleAt. T is the implementing type
+// itself.
+type CycleID[T any] interface {
+	// Effective returns the effective date of the cycle.
+	Effective() time.Time
+
+	// Expiry returns the end of the validity of the cycle (exclusive), i.e.
+	// the effective date of the following cycle.
+	Expiry() time.Time
+
+	// Identifier returns the conventional identifier of the cycle.
+	Identifier() string
+
+	// Compare returns -1, 0 or +1 if the cycle is before, the same as or
+	// after other.
+	Compare(other T) int
+}
+
+// static assert
+var (
+	_ CycleID[AIRAC]      = AIRAC(0)
+	_ CycleID[ChartCycle] = ChartCycle(0)
+)
+
+// Expiry returns the end of the validity of this AIRAC cycle (exclusive), i.e.
+// the effective date of the following cycle.
+func (a AIRAC) Expiry() time.Time {
+	return (a + 1).Effective()
+}
+
+// Identifier returns the identifier of this AIRAC cycle like String. "YYOO"
+func (a AIRAC) Identifier() string {
+	return a.String()
+}
+
+// Compare returns -1, 0 or +1 if this AIRAC cycle is before, the same as or
+// after b.
+func (a AIRAC) Compare(b AIRAC) int {
+	return compareSerials(uint16(a), uint16(b))
+}
+
+// Expiry returns the end of the validity of this chart cycle (exclusive), i.e.
+// the effective date of the following chart cycle.
+func (c ChartCycle) Expiry() time.Time {
+	return (c + 1).Effective()
+}
+
+// Identifier returns the effective date of this chart cycle like String.
+// "YYYY-MM-DD"
+func (c ChartCycle) Identifier() string {
+	return c.String()
+}
+
+// Compare returns -1, 0 or +1 if this chart cycle is before, the same as or
+// after d.
+func (c ChartCycle) Compare(d ChartCycle) int {
+	return compareSerials(uint16(c), uint16(d))
+}
+
+func compareSerials(a, b uint16) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	default:
+		return 0
+	}
+}
+
+// SortCycles sorts cycles in chronological order.
+func SortCycles[T CycleID[T]](cycles []T) {
+	sort.Slice(cycles, func(i, j int) bool { return cycles[i].Compare(cycles[j]) < 0 })
+}
+
+// LatestCycle returns the latest of cycles. It reports false if cycles is
+// empty.
+func LatestCycle[T CycleID[T]](cycles []T) (T, bool) {
+	var latest T
+	if len(cycles) == 0 {
+		return latest, false
+	}
+	latest = cycles[0]
+	for _, c := range cycles[1:] {
+		if c.Compare(latest) > 0 {
+			latest = c
+		}
+	}
+	return latest, true
+}
+
+// CycleContains reports whether date lies within the validity of cycle c,
+// i.e. from its effective date until its expiry (exclusive).
+func CycleContains[T CycleID[T]](c T, date time.Time) bool {
+	return !date.Before(c.Effective()) && date.Before(c.Expiry())
+}
+
+// CycleAt returns the first of cycles that contains date. It reports false if
+// there is no such cycle.
+func CycleAt[T CycleID[T]](cycles []T, date time.Time) (T, bool) {
+	for _, c := range cycles {
+		if CycleContains(c, date) {
+			return c, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// CycleIdentifiers returns the identifiers of cycles in the given order.
+func CycleIdentifiers[T CycleID[T]](cycles []T) []string {
+	ids := make([]string, len(cycles))
+	for i, c := range cycles {
+		ids[i] = c.Identifier()
+	}
+	return ids
+}
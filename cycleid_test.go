@@ -0,0 +1,132 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCycleIDAIRAC(t *testing.T) {
+	t.Parallel()
+
+	a := FromStringMust("2313")
+	if want := "2024-01-25"; a.Expiry().Format(format) != want {
+		t.Errorf("Want expiry %s, got %s", want, a.Expiry().Format(format))
+	}
+	if a.Identifier() != "2313" {
+		t.Errorf("Want identifier 2313, got %s", a.Identifier())
+	}
+
+	testt := []struct {
+		a, b AIRAC
+		want int
+	}{
+		{a, a + 1, -1},
+		{a, a, 0},
+		{a + 1, a, +1},
+	}
+	for _, tt := range testt {
+		if got := tt.a.Compare(tt.b); got != tt.want {
+			t.Errorf("%s.Compare(%s): Want %d, got %d", tt.a, tt.b, tt.want, got)
+		}
+	}
+}
+
+func TestCycleIDChartCycle(t *testing.T) {
+	t.Parallel()
+
+	c := FromChartDate(time.Date(2024, time.January, 25, 0, 0, 0, 0, time.UTC))
+	if want := "2024-03-21"; c.Expiry().Format(format) != want {
+		t.Errorf("Want expiry %s, got %s", want, c.Expiry().Format(format))
+	}
+	if c.Identifier() != "2024-01-25" {
+		t.Errorf("Want identifier 2024-01-25, got %s", c.Identifier())
+	}
+	if c.Compare(c+1) != -1 || c.Compare(c) != 0 || (c+1).Compare(c) != +1 {
+		t.Error("Chart cycles compare wrong")
+	}
+}
+
+func TestSortCycles(t *testing.T) {
+	t.Parallel()
+
+	cycles := []AIRAC{FromStringMust("2401"), FromStringMust("2312"), FromStringMust("2313")}
+	SortCycles(cycles)
+	if got := strings.Join(CycleIdentifiers(cycles), ","); got != "2312,2313,2401" {
+		t.Errorf("Want 2312,2313,2401, got %s", got)
+	}
+
+	charts := []ChartCycle{8, 3, 5}
+	SortCycles(charts)
+	if charts[0] != 3 || charts[1] != 5 || charts[2] != 8 {
+		t.Errorf("Want [3 5 8], got %v", []uint16{uint16(charts[0]), uint16(charts[1]), uint16(charts[2])})
+	}
+}
+
+func TestLatestCycle(t *testing.T) {
+	t.Parallel()
+
+	if _, ok := LatestCycle([]AIRAC(nil)); ok {
+		t.Error("Latest of no cycles should not exist")
+	}
+
+	latest, ok := LatestCycle([]AIRAC{FromStringMust("2312"), FromStringMust("2401"), FromStringMust("2313")})
+	if !ok || latest.String() != "2401" {
+		t.Errorf("Want 2401, got %s", latest)
+	}
+}
+
+func TestCycleAt(t *testing.T) {
+	t.Parallel()
+
+	cycles := []AIRAC{FromStringMust("2312"), FromStringMust("2313")}
+
+	testt := []struct {
+		date   time.Time
+		want   string
+		wantOK bool
+	}{
+		{time.Date(2023, time.November, 30, 0, 0, 0, 0, time.UTC), "2312", true},
+		{time.Date(2023, time.December, 27, 23, 59, 59, 0, time.UTC), "2312", true},
+		{time.Date(2023, time.December, 28, 0, 0, 0, 0, time.UTC), "2313", true},
+		{time.Date(2024, time.January, 25, 0, 0, 0, 0, time.UTC), "", false},
+		{time.Date(2023, time.November, 29, 0, 0, 0, 0, time.UTC), "", false},
+	}
+
+	for _, tt := range testt {
+		got, ok := CycleAt(cycles, tt.date)
+		if ok != tt.wantOK || (ok && got.String() != tt.want) {
+			t.Errorf("%s: Want %s (%t), got %s (%t)", tt.date, tt.want, tt.wantOK, got, ok)
+		}
+	}
+}
+
+func ExampleCycleAt() {
+	date := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	airacs := []AIRAC{FromStringMust("2313"), FromStringMust("2401"), FromStringMust("2402")}
+	a, _ := CycleAt(airacs, date)
+
+	charts := []ChartCycle{FromChartDate(date), FromChartDate(date) + 1}
+	c, _ := CycleAt(charts, date)
+
+	fmt.Println(a.Identifier(), c.Identifier())
+	// Output: 2401 2024-01-25
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FromDAFIF returns the AIRAC cycle that matches the cycle identifier
+// <yyyyoo> of the Digital Aeronautical Flight Information File (DAFIF) of the
+// US Department of Defense. DAFIF cycles become effective on AIRAC effective
+// dates and are identified by the four digits of the year and the ordinal with
+// leading zeros, e.g. "202313".
+func FromDAFIF(yyyyoo string) (AIRAC, error) {
+	yyyyoo = strings.TrimSpace(yyyyoo)
+	if len(yyyyoo) != 6 {
+		return 0, fmt.Errorf("illegal DAFIF cycle %q", yyyyoo)
+	}
+
+	for _, r := range yyyyoo {
+		if r < '0' || r > '9' {
+			return 0, fmt.Errorf("illegal DAFIF cycle %q", yyyyoo)
+		}
+	}
+
+	year, _ := strconv.Atoi(yyyyoo[:4])
+	ordinal, _ := strconv.Atoi(yyyyoo[4:])
+	if year < _epoch.Year() {
+		return 0, fmt.Errorf("illegal DAFIF cycle %q", yyyyoo)
+	}
+
+	airac, ok := _icao.fromYearOrdinal(year, ordinal)
+	if !ok {
+		return 0, fmt.Errorf("illegal DAFIF cycle %q", yyyyoo)
+	}
+
+	return airac, nil
+}
+
+// DAFIF returns the DAFIF cycle identifier of this AIRAC cycle. "YYYYOO"
+func (a AIRAC) DAFIF() string {
+	return fmt.Sprintf("%04d%02d", a.Year(), a.Ordinal())
+}
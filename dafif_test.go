@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"testing"
+)
+
+func TestFromDAFIF(t *testing.T) {
+	t.Parallel()
+
+	testt := []struct {
+		dafif string
+		airac string
+		valid bool
+	}{
+		{"202014", "2014", true},
+		{"202313", "2313", true},
+		{"199801", "9801", true},
+		{"2064011", "", false},
+		{"202314", "", false},
+		{"202300", "", false},
+		{"2313", "", false},
+		{"+02313", "", false},
+		{"20a313", "", false},
+		{"190001", "", false},
+	}
+
+	for _, tt := range testt {
+		got, err := FromDAFIF(tt.dafif)
+		if !tt.valid {
+			if err == nil {
+				t.Errorf("DAFIF %q parsed to %s, but should have raised an error", tt.dafif, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("DAFIF %q did not parse: %v", tt.dafif, err)
+			continue
+		}
+		if got.String() != tt.airac {
+			t.Errorf("DAFIF %q: want %s, got %s", tt.dafif, tt.airac, got)
+		}
+		if got.DAFIF() != tt.dafif {
+			t.Errorf("DAFIF %q: round trip yields %q", tt.dafif, got.DAFIF())
+		}
+	}
+}
@@ -0,0 +1,212 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dataset
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jwkohnen/airac"
+)
+
+// Activation is the record that the dataset of an AIRAC cycle went live in a
+// system.
+type Activation struct {
+	AIRAC     airac.AIRAC `json:"airac"`
+	Activated time.Time   `json:"activated"`
+	Actor     string      `json:"actor,omitempty"`
+	Notes     string      `json:"notes,omitempty"`
+}
+
+// Delay returns how late the activation was relative to the effective date of
+// the cycle. It is negative for early activations.
+func (a Activation) Delay() time.Duration {
+	return a.Activated.Sub(a.AIRAC.Effective())
+}
+
+// LogStore persists activation records. Records are only ever appended.
+// Implementations must be safe for concurrent use.
+type LogStore interface {
+	// Append appends record a.
+	Append(a Activation) error
+
+	// Entries returns all records in order of appending.
+	Entries() ([]Activation, error)
+}
+
+// MemoryLog is a LogStore that keeps records in memory.
+type MemoryLog struct {
+	mu      sync.Mutex
+	entries []Activation
+}
+
+// static assert
+var (
+	_ LogStore = (*MemoryLog)(nil)
+	_ LogStore = (*FileLog)(nil)
+)
+
+// NewMemoryLog returns an empty MemoryLog.
+func NewMemoryLog() *MemoryLog {
+	return &MemoryLog{}
+}
+
+// Append appends record a.
+func (l *MemoryLog) Append(a Activation) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.entries = append(l.entries, a)
+	return nil
+}
+
+// Entries returns all records in order of appending.
+func (l *MemoryLog) Entries() ([]Activation, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return append([]Activation(nil), l.entries...), nil
+}
+
+// FileLog is a LogStore that appends records to a file as JSON lines, e.g.
+// {"airac":1604,"activated":"2023-12-28T00:05:00Z","actor":"ops"}.
+type FileLog struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileLog returns a FileLog that appends to the file at path. The file is
+// created on the first append.
+func NewFileLog(path string) *FileLog {
+	return &FileLog{path: path}
+}
+
+// Append appends record a to the file.
+func (l *FileLog) Append(a Activation) error {
+	line, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f, err := os.OpenFile(l.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Entries reads all records of the file. A missing file has no records.
+func (l *FileLog) Entries() ([]Activation, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f, err := os.Open(l.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var (
+		entries []Activation
+		scanner = bufio.NewScanner(f)
+	)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var a Activation
+		if err := json.Unmarshal(scanner.Bytes(), &a); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", l.path, line, err)
+		}
+		entries = append(entries, a)
+	}
+	return entries, scanner.Err()
+}
+
+// AuditLog is an append-only log of the activations of AIRAC cycles in a
+// system, e.g. for compliance records of when each cycle actually went live.
+// It is safe for concurrent use.
+type AuditLog struct {
+	store LogStore
+}
+
+// NewAuditLog returns an AuditLog that persists its records in store.
+func NewAuditLog(store LogStore) *AuditLog {
+	return &AuditLog{store: store}
+}
+
+// Record records that cycle a went live at activated.
+func (l *AuditLog) Record(a airac.AIRAC, activated time.Time, actor, notes string) error {
+	return l.store.Append(Activation{AIRAC: a, Activated: activated, Actor: actor, Notes: notes})
+}
+
+// Entries returns all records in chronological order of activation. Records
+// with equal activation times are in order of recording.
+func (l *AuditLog) Entries() ([]Activation, error) {
+	entries, err := l.store.Entries()
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Activated.Before(entries[j].Activated) })
+	return entries, nil
+}
+
+// ActiveAt returns the record of the activation that was in effect at t, i.e.
+// the latest activation at or before t. It returns false if nothing had been
+// activated at t.
+func (l *AuditLog) ActiveAt(t time.Time) (Activation, bool, error) {
+	entries, err := l.Entries()
+	if err != nil {
+		return Activation{}, false, err
+	}
+	i := sort.Search(len(entries), func(i int) bool { return entries[i].Activated.After(t) })
+	if i == 0 {
+		return Activation{}, false, nil
+	}
+	return entries[i-1], true, nil
+}
+
+// Late returns the records of activations whose Delay exceeds tolerance in
+// chronological order.
+func (l *AuditLog) Late(tolerance time.Duration) ([]Activation, error) {
+	entries, err := l.Entries()
+	if err != nil {
+		return nil, err
+	}
+	var late []Activation
+	for _, a := range entries {
+		if a.Delay() > tolerance {
+			late = append(late, a)
+		}
+	}
+	return late, nil
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dataset
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jwkohnen/airac"
+)
+
+func TestAuditLog(t *testing.T) {
+	t.Parallel()
+
+	stores := map[string]LogStore{
+		"memory": NewMemoryLog(),
+		"file":   NewFileLog(filepath.Join(t.TempDir(), "activations.jsonl")),
+	}
+	for name, store := range stores {
+		l := NewAuditLog(store)
+
+		c2312, c2313 := airac.FromStringMust("2312"), airac.FromStringMust("2313")
+		records := []Activation{
+			{c2313, c2313.Effective().Add(3 * time.Hour), "bob", "late, vendor delivery"},
+			{c2312, c2312.Effective().Add(-time.Hour), "alice", ""},
+			{c2312, c2313.Effective().Add(4 * time.Hour), "bob", "rollback"},
+		}
+		for _, r := range records {
+			if err := l.Record(r.AIRAC, r.Activated, r.Actor, r.Notes); err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+		}
+
+		entries, err := l.Entries()
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if len(entries) != 3 || entries[0].Actor != "alice" || entries[2].Notes != "rollback" {
+			t.Errorf("%s: want chronological entries, got %+v", name, entries)
+		}
+		if !entries[1].Activated.Equal(records[0].Activated) || entries[1].AIRAC != c2313 {
+			t.Errorf("%s: want %+v, got %+v", name, records[0], entries[1])
+		}
+
+		testt := []struct {
+			at   time.Time
+			want airac.AIRAC
+			ok   bool
+		}{
+			{c2312.Effective().Add(-2 * time.Hour), 0, false},
+			{c2312.Effective(), c2312, true},
+			{c2313.Effective(), c2312, true},
+			{c2313.Effective().Add(3 * time.Hour), c2313, true},
+			{c2313.Effective().Add(5 * time.Hour), c2312, true},
+		}
+		for _, tt := range testt {
+			got, ok, err := l.ActiveAt(tt.at)
+			if err != nil || ok != tt.ok || (ok && got.AIRAC != tt.want) {
+				t.Errorf("%s: %s: want %s (%t), got %s (%t, %v)", name, tt.at, tt.want, tt.ok, got.AIRAC, ok, err)
+			}
+		}
+
+		late, err := l.Late(time.Hour)
+		if err != nil || len(late) != 2 || late[0].AIRAC != c2313 || late[1].Notes != "rollback" {
+			t.Errorf("%s: want 2313 and the rollback late, got %+v (%v)", name, late, err)
+		}
+		if d := entries[0].Delay(); d != -time.Hour {
+			t.Errorf("%s: want delay -1h, got %s", name, d)
+		}
+	}
+}
+
+func TestFileLog(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "activations.jsonl")
+	l := NewFileLog(path)
+	if entries, err := l.Entries(); err != nil || len(entries) != 0 {
+		t.Errorf("Want no entries, got %v (%v)", entries, err)
+	}
+
+	a := airac.FromStringMust("2313")
+	if err := l.Append(Activation{AIRAC: a, Activated: a.Effective(), Actor: "ops"}); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"airac":1604,"activated":"2023-12-28T00:00:00Z","actor":"ops"}` + "\n"; string(data) != want {
+		t.Errorf("Want %q, got %q", want, data)
+	}
+
+	if err := os.WriteFile(path, append(data, "\n{broken\n"...), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l.Entries(); err == nil {
+		t.Error("Reading a broken log should have raised an error")
+	}
+
+	if err := NewFileLog(filepath.Join(path, "not-a-dir")).Append(Activation{}); err == nil {
+		t.Error("Appending to an illegal path should have raised an error")
+	}
+}
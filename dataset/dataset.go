@@ -0,0 +1,318 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package dataset tracks the lifecycle of datasets that are keyed by AIRAC
+// cycle, e.g. navdata or AIP data sets, from registration until they are
+// superseded by a newer cycle.
+package dataset
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jwkohnen/airac"
+)
+
+// State is the lifecycle state of a dataset.
+type State int
+
+// The lifecycle states of a dataset in order. A dataset advances one state
+// at a time, except that it may become superseded from any state.
+const (
+	Registered State = iota + 1
+	Downloaded
+	Validated
+	Activated
+	Superseded
+)
+
+// String returns the name of this state.
+func (s State) String() string {
+	switch s {
+	case Registered:
+		return "registered"
+	case Downloaded:
+		return "downloaded"
+	case Validated:
+		return "validated"
+	case Activated:
+		return "activated"
+	case Superseded:
+		return "superseded"
+	default:
+		return fmt.Sprintf("State(%d)", int(s))
+	}
+}
+
+// Dataset is the dataset of an AIRAC cycle and its lifecycle state.
+type Dataset struct {
+	AIRAC   airac.AIRAC
+	State   State
+	Updated time.Time
+}
+
+// Transition is a change of the lifecycle state of a dataset.
+type Transition struct {
+	AIRAC airac.AIRAC
+	From  State
+	To    State
+	At    time.Time
+}
+
+// Store persists datasets. Implementations must be safe for concurrent use.
+type Store interface {
+	// Load returns the dataset of cycle a. It returns false if there is no
+	// such dataset.
+	Load(a airac.AIRAC) (Dataset, bool, error)
+
+	// Save creates or replaces the dataset of d.AIRAC.
+	Save(d Dataset) error
+
+	// List returns all datasets in any order.
+	List() ([]Dataset, error)
+}
+
+// MemoryStore is a Store that keeps datasets in memory.
+type MemoryStore struct {
+	mu       sync.Mutex
+	datasets map[airac.AIRAC]Dataset
+}
+
+// static assert
+var _ Store = (*MemoryStore)(nil)
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{datasets: make(map[airac.AIRAC]Dataset)}
+}
+
+// Load returns the dataset of cycle a.
+func (s *MemoryStore) Load(a airac.AIRAC) (Dataset, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, ok := s.datasets[a]
+	return d, ok, nil
+}
+
+// Save creates or replaces the dataset of d.AIRAC.
+func (s *MemoryStore) Save(d Dataset) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.datasets[d.AIRAC] = d
+	return nil
+}
+
+// List returns all datasets.
+func (s *MemoryStore) List() ([]Dataset, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list := make([]Dataset, 0, len(s.datasets))
+	for _, d := range s.datasets {
+		list = append(list, d)
+	}
+	return list, nil
+}
+
+// Manager manages the lifecycle of datasets kept in a Store. It is safe for
+// concurrent use, as long as no other party modifies the store.
+type Manager struct {
+	mu     sync.Mutex
+	store  Store
+	notify func(Transition)
+}
+
+// NewManager returns a Manager that keeps datasets in store. If notify is not
+// nil, it is called for every transition after it has been saved. It is called
+// without holding the lock of the Manager, so it may call back into the
+// Manager, but notifications of concurrent calls may interleave.
+func NewManager(store Store, notify func(Transition)) *Manager {
+	return &Manager{store: store, notify: notify}
+}
+
+// Register registers the dataset of cycle a.
+func (m *Manager) Register(a airac.AIRAC, now time.Time) error {
+	t, err := m.register(a, now)
+	if err != nil {
+		return err
+	}
+	m.notifyAll(t)
+	return nil
+}
+
+func (m *Manager) register(a airac.AIRAC, now time.Time) (Transition, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok, err := m.store.Load(a); err != nil {
+		return Transition{}, err
+	} else if ok {
+		return Transition{}, fmt.Errorf("dataset %s already registered", a)
+	}
+
+	return m.transition(Dataset{AIRAC: a}, Registered, now)
+}
+
+// Advance advances the dataset of cycle a to state to.
+func (m *Manager) Advance(a airac.AIRAC, to State, now time.Time) error {
+	t, err := m.advance(a, to, now)
+	if err != nil {
+		return err
+	}
+	m.notifyAll(t)
+	return nil
+}
+
+func (m *Manager) advance(a airac.AIRAC, to State, now time.Time) (Transition, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	d, ok, err := m.store.Load(a)
+	if err != nil {
+		return Transition{}, err
+	}
+	if !ok {
+		return Transition{}, fmt.Errorf("dataset %s not registered", a)
+	}
+
+	if !(to == d.State+1 || (to == Superseded && d.State < Superseded)) {
+		return Transition{}, fmt.Errorf("dataset %s: illegal transition from %s to %s", a, d.State, to)
+	}
+
+	return m.transition(d, to, now)
+}
+
+// Get returns the dataset of cycle a. It returns false if a is not registered.
+func (m *Manager) Get(a airac.AIRAC) (Dataset, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.store.Load(a)
+}
+
+// List returns all datasets in chronological order of their cycles.
+func (m *Manager) List() ([]Dataset, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.list()
+}
+
+// Due returns the dataset that should be active at now, i.e. the dataset of
+// the newest cycle not later than the cycle effective at now, that has been
+// validated or activated. It returns false if there is no such dataset.
+func (m *Manager) Due(now time.Time) (Dataset, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.due(now)
+}
+
+// Rollover activates the dataset that should be active at now and supersedes
+// all datasets of older cycles that are not superseded yet. It returns the
+// resulting transitions in order.
+func (m *Manager) Rollover(now time.Time) ([]Transition, error) {
+	transitions, err := m.rollover(now)
+	m.notifyAll(transitions...)
+	return transitions, err
+}
+
+func (m *Manager) rollover(now time.Time) ([]Transition, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	due, ok, err := m.due(now)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	list, err := m.list()
+	if err != nil {
+		return nil, err
+	}
+
+	var transitions []Transition
+	for _, d := range list {
+		var to State
+		switch {
+		case d.AIRAC == due.AIRAC && d.State == Validated:
+			to = Activated
+		case d.AIRAC < due.AIRAC && d.State != Superseded:
+			to = Superseded
+		default:
+			continue
+		}
+
+		t, err := m.transition(d, to, now)
+		if err != nil {
+			return transitions, err
+		}
+		transitions = append(transitions, t)
+	}
+	return transitions, nil
+}
+
+func (m *Manager) due(now time.Time) (Dataset, bool, error) {
+	list, err := m.list()
+	if err != nil {
+		return Dataset{}, false, err
+	}
+
+	current := airac.FromDate(now)
+	for i := len(list) - 1; i >= 0; i-- {
+		d := list[i]
+		if d.AIRAC <= current && (d.State == Validated || d.State == Activated) {
+			return d, true, nil
+		}
+	}
+	return Dataset{}, false, nil
+}
+
+func (m *Manager) list() ([]Dataset, error) {
+	list, err := m.store.List()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].AIRAC < list[j].AIRAC })
+	return list, nil
+}
+
+// transition saves dataset d in state to. The caller must hold m.mu and
+// notify the transition after releasing it.
+func (m *Manager) transition(d Dataset, to State, now time.Time) (Transition, error) {
+	t := Transition{AIRAC: d.AIRAC, From: d.State, To: to, At: now}
+
+	d.State, d.Updated = to, now
+	if err := m.store.Save(d); err != nil {
+		return Transition{}, err
+	}
+	return t, nil
+}
+
+// notifyAll calls the notify function for transitions. The caller must not
+// hold m.mu.
+func (m *Manager) notifyAll(transitions ...Transition) {
+	if m.notify == nil {
+		return
+	}
+	for _, t := range transitions {
+		m.notify(t)
+	}
+}
@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dataset
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jwkohnen/airac"
+)
+
+func TestManager(t *testing.T) {
+	t.Parallel()
+
+	var transitions []Transition
+	m := NewManager(NewMemoryStore(), func(t Transition) { transitions = append(transitions, t) })
+
+	c2312, c2313 := airac.FromStringMust("2312"), airac.FromStringMust("2313")
+	now := c2312.Effective()
+
+	for _, a := range []airac.AIRAC{c2312, c2313} {
+		if err := m.Register(a, now); err != nil {
+			t.Fatal(err)
+		}
+		for _, s := range []State{Downloaded, Validated} {
+			if err := m.Advance(a, s, now); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if got, want := len(transitions), 6; got != want {
+		t.Errorf("Want %d transitions, got %d: %v", want, got, transitions)
+	}
+
+	due, ok, err := m.Due(now)
+	if err != nil || !ok || due.AIRAC != c2312 {
+		t.Errorf("Want due dataset %s, got %v (%t, %v)", c2312, due, ok, err)
+	}
+
+	rolled, err := m.Rollover(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rolled) != 1 || rolled[0].AIRAC != c2312 || rolled[0].To != Activated {
+		t.Errorf("Want activation of %s, got %v", c2312, rolled)
+	}
+
+	rolled, err = m.Rollover(c2313.Effective())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rolled) != 2 ||
+		rolled[0].AIRAC != c2312 || rolled[0].To != Superseded ||
+		rolled[1].AIRAC != c2313 || rolled[1].To != Activated {
+		t.Errorf("Want supersession of %s and activation of %s, got %v", c2312, c2313, rolled)
+	}
+
+	if d, _, _ := m.Get(c2313); d.State != Activated {
+		t.Errorf("Want %s activated, got %s", c2313, d.State)
+	}
+	if got, want := len(transitions), 9; got != want {
+		t.Errorf("Want %d transitions, got %d: %v", want, got, transitions)
+	}
+}
+
+func TestManagerIllegalTransitions(t *testing.T) {
+	t.Parallel()
+
+	m := NewManager(NewMemoryStore(), nil)
+	a := airac.FromStringMust("2313")
+	now := time.Now()
+
+	if err := m.Advance(a, Downloaded, now); err == nil {
+		t.Error("Advancing an unregistered dataset should have raised an error")
+	}
+	if err := m.Register(a, now); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Register(a, now); err == nil {
+		t.Error("Registering a dataset twice should have raised an error")
+	}
+	if err := m.Advance(a, Validated, now); err == nil {
+		t.Error("Skipping a state should have raised an error")
+	}
+	if err := m.Advance(a, Superseded, now); err != nil {
+		t.Errorf("Superseding a registered dataset: %v", err)
+	}
+	if err := m.Advance(a, Superseded, now); err == nil {
+		t.Error("Superseding a superseded dataset should have raised an error")
+	}
+}
+
+func TestManagerDueNone(t *testing.T) {
+	t.Parallel()
+
+	m := NewManager(NewMemoryStore(), nil)
+	a := airac.FromStringMust("2313")
+	if err := m.Register(a, a.Effective()); err != nil {
+		t.Fatal(err)
+	}
+
+	if d, ok, err := m.Due(a.Effective()); ok || err != nil {
+		t.Errorf("Want no due dataset, got %v (%v)", d, err)
+	}
+	if rolled, err := m.Rollover(a.Effective()); len(rolled) != 0 || err != nil {
+		t.Errorf("Want no transitions, got %v (%v)", rolled, err)
+	}
+}
+
+func TestManagerReentrantNotify(t *testing.T) {
+	t.Parallel()
+
+	var (
+		m      *Manager
+		states []State
+	)
+	m = NewManager(NewMemoryStore(), func(tr Transition) {
+		d, ok, err := m.Get(tr.AIRAC)
+		if err != nil || !ok {
+			t.Errorf("Want dataset %s, got %v (%v)", tr.AIRAC, ok, err)
+		}
+		states = append(states, d.State)
+	})
+
+	a := airac.FromStringMust("2313")
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := m.Register(a, a.Effective()); err != nil {
+			t.Error(err)
+		}
+		for _, s := range []State{Downloaded, Validated} {
+			if err := m.Advance(a, s, a.Effective()); err != nil {
+				t.Error(err)
+			}
+		}
+		if _, err := m.Rollover(a.Effective()); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("notify calling back into the Manager deadlocked")
+	}
+	if want := []State{Registered, Downloaded, Validated, Activated}; len(states) != len(want) || states[3] != Activated {
+		t.Errorf("Want states %v, got %v", want, states)
+	}
+}
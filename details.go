@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+// Details is the full metadata of an AIRAC cycle, designed for direct
+// serialization, e.g. to JSON or YAML. The dates are formatted "YYYY-MM-DD"
+// like in LongString, i.e. Expires is the last day of the cycle.
+type Details struct {
+	Identifier   string `json:"identifier" yaml:"identifier"`
+	Serial       uint16 `json:"serial" yaml:"serial"`
+	Year         int    `json:"year" yaml:"year"`
+	Ordinal      int    `json:"ordinal" yaml:"ordinal"`
+	Effective    string `json:"effective" yaml:"effective"`
+	Expires      string `json:"expires" yaml:"expires"`
+	DurationDays int    `json:"durationDays" yaml:"durationDays"`
+}
+
+// Details returns the full metadata of this AIRAC cycle.
+func (a AIRAC) Details() Details {
+	effective, next := a.Effective(), (a + 1).Effective()
+	return Details{
+		Identifier:   a.String(),
+		Serial:       uint16(a),
+		Year:         a.Year(),
+		Ordinal:      a.Ordinal(),
+		Effective:    effective.Format(format),
+		Expires:      next.Add(-1).Format(format),
+		DurationDays: int(next.Sub(effective).Hours() / 24),
+	}
+}
@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestDetails(t *testing.T) {
+	t.Parallel()
+
+	got := FromStringMust("2014").Details()
+	want := Details{
+		Identifier:   "2014",
+		Serial:       1565,
+		Year:         2020,
+		Ordinal:      14,
+		Effective:    "2020-12-31",
+		Expires:      "2021-01-27",
+		DurationDays: 28,
+	}
+	if got != want {
+		t.Errorf("Want %+v, got %+v", want, got)
+	}
+}
+
+func ExampleAIRAC_Details() {
+	b, err := json.Marshal(FromStringMust("2313").Details())
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(b))
+
+	// Output:
+	// {"identifier":"2313","serial":1604,"year":2023,"ordinal":13,"effective":"2023-12-28","expires":"2024-01-24","durationDays":28}
+}
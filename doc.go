@@ -49,7 +49,9 @@ package airac
      dates, 00:01 UTC must be used to indicate the time when the AIRAC-based
      information will become effective."
 
-   However I won't "fix" this, because that may just confuse users. */
+   However I won't "fix" this, because that may just confuse users. A
+   calendar that follows paragraph 2.6.4 is available as
+   ICAO().WithOffsetMust(time.Minute). */
 
 // nolint:godox
 /* BUG(jwkohnen): Calculations that include calendar dates before the internal
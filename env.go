@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// FromEnv returns the AIRAC cycle of the identifier in the environment
+// variable key, parsed with ParseLenient, e.g. AIRAC_CYCLE="AIRAC 2313". It
+// returns an error if the variable is unset, empty or not a valid identifier.
+func FromEnv(key string) (AIRAC, error) {
+	value, ok := os.LookupEnv(key)
+	if !ok || strings.TrimSpace(value) == "" {
+		return 0, fmt.Errorf("environment variable %s is not set", key)
+	}
+	return fromEnvValue(key, value)
+}
+
+// FromEnvOrCurrent returns the AIRAC cycle of the environment variable key
+// like FromEnv, but falls back to the current cycle if the variable is unset
+// or empty. An invalid identifier is still an error.
+func FromEnvOrCurrent(key string) (AIRAC, error) {
+	value, ok := os.LookupEnv(key)
+	if !ok || strings.TrimSpace(value) == "" {
+		return Current(), nil
+	}
+	return fromEnvValue(key, value)
+}
+
+func fromEnvValue(key, value string) (AIRAC, error) {
+	a, err := Parse(value, ParseLenient)
+	if err != nil {
+		return 0, fmt.Errorf("environment variable %s: %w", key, err)
+	}
+	return a, nil
+}
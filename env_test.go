@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+// The tests of this file use t.Setenv and must not run in parallel.
+
+func TestFromEnv(t *testing.T) {
+	testt := []struct {
+		value   string
+		want    string
+		ok      bool
+		current bool
+	}{
+		{"2313", "2313", true, false},
+		{" AIRAC 23/13 ", "2313", true, false},
+		{"", "", false, true},
+		{"  ", "", false, true},
+		{"2314", "", false, false},
+		{"next", "", false, false},
+	}
+	for _, tt := range testt {
+		t.Setenv("AIRAC_TEST_CYCLE", tt.value)
+
+		got, err := FromEnv("AIRAC_TEST_CYCLE")
+		if tt.ok != (err == nil) {
+			t.Errorf("%q: want ok %t, got %v", tt.value, tt.ok, err)
+		} else if tt.ok && got.String() != tt.want {
+			t.Errorf("%q: want %s, got %s", tt.value, tt.want, got)
+		}
+		if err != nil && !strings.Contains(err.Error(), "AIRAC_TEST_CYCLE") {
+			t.Errorf("%q: error does not name the variable: %v", tt.value, err)
+		}
+
+		got, err = FromEnvOrCurrent("AIRAC_TEST_CYCLE")
+		switch {
+		case tt.current:
+			if err != nil || got != Current() {
+				t.Errorf("%q: want current cycle, got %s (%v)", tt.value, got, err)
+			}
+		case tt.ok != (err == nil):
+			t.Errorf("%q: want ok %t, got %v", tt.value, tt.ok, err)
+		case tt.ok && got.String() != tt.want:
+			t.Errorf("%q: want %s, got %s", tt.value, tt.want, got)
+		}
+	}
+}
+
+func TestFromEnvUnset(t *testing.T) {
+	const key = "AIRAC_TEST_CYCLE_UNSET"
+	t.Setenv(key, "")
+	if err := os.Unsetenv(key); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, err := FromEnv(key); err == nil {
+		t.Errorf("Got %s, but should have raised an error", got)
+	}
+	if got, err := FromEnvOrCurrent(key); err != nil || got != Current() {
+		t.Errorf("Want current cycle, got %s (%v)", got, err)
+	}
+}
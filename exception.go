@@ -0,0 +1,195 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ExceptionKind is the kind of deviation of an authority from the AIRAC grid.
+type ExceptionKind int
+
+// The kinds of exceptions.
+const (
+	// ExceptionSkipped is a cycle the authority published "NIL" for. The data
+	// operative before the cycle stays operative throughout it.
+	ExceptionSkipped ExceptionKind = iota + 1
+
+	// ExceptionPostponed is a cycle the authority activated later than its
+	// effective date, at Exception.Effective. The data operative before the
+	// cycle stays operative until then.
+	ExceptionPostponed
+
+	// ExceptionReplaced is a cycle whose data the authority withdrew in
+	// favour of the data of cycle Exception.ReplacedBy, which is operative
+	// throughout the cycle instead.
+	ExceptionReplaced
+)
+
+// String returns the name of this kind.
+func (k ExceptionKind) String() string {
+	switch k {
+	case ExceptionSkipped:
+		return "Skipped"
+	case ExceptionPostponed:
+		return "Postponed"
+	case ExceptionReplaced:
+		return "Replaced"
+	default:
+		return fmt.Sprintf("ExceptionKind(%d)", int(k))
+	}
+}
+
+// Exception is a deviation of an authority, e.g. a national AIP, from the
+// AIRAC grid for one cycle.
+type Exception struct {
+	Authority string
+	AIRAC     AIRAC
+	Kind      ExceptionKind
+
+	// Effective is the actual activation of a postponed cycle. It must lie
+	// after the effective date of the cycle and before that of the following
+	// cycle.
+	Effective time.Time
+
+	// ReplacedBy is the cycle whose data is operative instead of a replaced
+	// cycle.
+	ReplacedBy AIRAC
+
+	// Notes is free text, e.g. a reference to the AIC announcing the
+	// exception.
+	Notes string
+}
+
+func (e Exception) validate() error {
+	switch e.Kind {
+	case ExceptionSkipped:
+	case ExceptionPostponed:
+		if !e.Effective.After(e.AIRAC.Effective()) || !e.Effective.Before(e.AIRAC.Expiry()) {
+			return fmt.Errorf("illegal activation %s of postponed AIRAC cycle %s of %q",
+				e.Effective.Format(time.RFC3339), e.AIRAC, e.Authority)
+		}
+	case ExceptionReplaced:
+		if e.ReplacedBy == e.AIRAC {
+			return fmt.Errorf("AIRAC cycle %s of %q replaced by itself", e.AIRAC, e.Authority)
+		}
+	default:
+		return fmt.Errorf("illegal exception kind %d for AIRAC cycle %s of %q", int(e.Kind), e.AIRAC, e.Authority)
+	}
+	return nil
+}
+
+// Exceptions is a registry of per-authority exceptions from the AIRAC grid.
+// The zero value is an empty registry ready to use. It is safe for concurrent
+// use.
+type Exceptions struct {
+	mu          sync.RWMutex
+	byAuthority map[string]map[AIRAC]Exception
+}
+
+// NewExceptions returns a registry of the given exceptions.
+func NewExceptions(exceptions ...Exception) (*Exceptions, error) {
+	x := new(Exceptions)
+	for _, e := range exceptions {
+		if err := x.Add(e); err != nil {
+			return nil, err
+		}
+	}
+	return x, nil
+}
+
+// Add adds exception e. It returns an error if e is inconsistent or if there
+// already is an exception for the cycle and authority of e.
+func (x *Exceptions) Add(e Exception) error {
+	if err := e.validate(); err != nil {
+		return err
+	}
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	if x.byAuthority == nil {
+		x.byAuthority = make(map[string]map[AIRAC]Exception)
+	}
+	cycles := x.byAuthority[e.Authority]
+	if cycles == nil {
+		cycles = make(map[AIRAC]Exception)
+		x.byAuthority[e.Authority] = cycles
+	}
+	if _, ok := cycles[e.AIRAC]; ok {
+		return fmt.Errorf("duplicate exception for AIRAC cycle %s of %q", e.AIRAC, e.Authority)
+	}
+	cycles[e.AIRAC] = e
+	return nil
+}
+
+// Lookup returns the exception of authority for cycle a. It reports false if
+// there is none.
+func (x *Exceptions) Lookup(authority string, a AIRAC) (Exception, bool) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	e, ok := x.byAuthority[authority][a]
+	return e, ok
+}
+
+// Authority returns the exceptions of authority in chronological order.
+func (x *Exceptions) Authority(authority string) []Exception {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	exceptions := make([]Exception, 0, len(x.byAuthority[authority]))
+	for _, e := range x.byAuthority[authority] {
+		exceptions = append(exceptions, e)
+	}
+	sort.Slice(exceptions, func(i, j int) bool { return exceptions[i].AIRAC < exceptions[j].AIRAC })
+	return exceptions
+}
+
+// Published reports whether authority published data for cycle a, i.e.
+// whether the cycle was neither skipped nor replaced.
+func (x *Exceptions) Published(authority string, a AIRAC) bool {
+	e, ok := x.Lookup(authority, a)
+	return !ok || e.Kind == ExceptionPostponed
+}
+
+// Operative returns the cycle whose data of authority is operative at t, i.e.
+// the cycle effective at t unless an exception of authority says otherwise.
+// Skipped cycles and postponed cycles before their activation resolve to the
+// cycle operative before them, replaced cycles to their replacement.
+func (x *Exceptions) Operative(authority string, t time.Time) AIRAC {
+	a := FromDate(t)
+	for {
+		e, ok := x.Lookup(authority, a)
+		switch {
+		case !ok:
+			return a
+		case e.Kind == ExceptionReplaced:
+			return e.ReplacedBy
+		case e.Kind == ExceptionPostponed && !t.Before(e.Effective):
+			return a
+		case a == 0:
+			return a
+		}
+		// skipped or not activated yet: fall back to the previous cycle
+		t = a.Effective().Add(-1)
+		a--
+	}
+}
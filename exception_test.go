@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func testExceptions(t *testing.T) *Exceptions {
+	t.Helper()
+
+	x, err := NewExceptions(
+		Exception{Authority: "EX", AIRAC: FromStringMust("2402"), Kind: ExceptionSkipped, Notes: "NIL"},
+		Exception{Authority: "EX", AIRAC: FromStringMust("2403"), Kind: ExceptionSkipped},
+		Exception{
+			Authority: "EX",
+			AIRAC:     FromStringMust("2405"),
+			Kind:      ExceptionPostponed,
+			Effective: time.Date(2024, time.May, 23, 0, 0, 0, 0, time.UTC),
+		},
+		Exception{Authority: "EX", AIRAC: FromStringMust("2407"), Kind: ExceptionReplaced, ReplacedBy: FromStringMust("2406")},
+		Exception{Authority: "EY", AIRAC: FromStringMust("2401"), Kind: ExceptionSkipped},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return x
+}
+
+func TestExceptionsAdd(t *testing.T) {
+	t.Parallel()
+
+	x := testExceptions(t)
+	a := FromStringMust("2405")
+
+	testt := []struct {
+		name string
+		e    Exception
+	}{
+		{"duplicate", Exception{Authority: "EX", AIRAC: FromStringMust("2402"), Kind: ExceptionSkipped}},
+		{"no kind", Exception{Authority: "EX", AIRAC: FromStringMust("2410")}},
+		{"postponed without activation", Exception{Authority: "EZ", AIRAC: a, Kind: ExceptionPostponed}},
+		{"postponed into next cycle", Exception{Authority: "EZ", AIRAC: a, Kind: ExceptionPostponed, Effective: a.Expiry()}},
+		{"replaced by itself", Exception{Authority: "EZ", AIRAC: a, Kind: ExceptionReplaced, ReplacedBy: a}},
+	}
+	for _, tt := range testt {
+		if err := x.Add(tt.e); err == nil {
+			t.Errorf("Adding exception %s should have raised an error", tt.name)
+		}
+	}
+
+	var zero Exceptions
+	if err := zero.Add(Exception{Authority: "EZ", AIRAC: a, Kind: ExceptionSkipped}); err != nil {
+		t.Error(err)
+	}
+	if _, ok := zero.Lookup("EZ", a); !ok {
+		t.Error("Want exception in zero value registry")
+	}
+}
+
+func TestExceptionsPublished(t *testing.T) {
+	t.Parallel()
+
+	x := testExceptions(t)
+	testt := []struct {
+		authority string
+		id        string
+		want      bool
+	}{
+		{"EX", "2401", true},
+		{"EX", "2402", false},
+		{"EX", "2405", true},
+		{"EX", "2407", false},
+		{"EY", "2401", false},
+		{"EY", "2402", true},
+		{"EZ", "2402", true},
+	}
+	for _, tt := range testt {
+		if got := x.Published(tt.authority, FromStringMust(tt.id)); got != tt.want {
+			t.Errorf("%s %s: Want %t, got %t", tt.authority, tt.id, tt.want, got)
+		}
+	}
+}
+
+func TestExceptionsOperative(t *testing.T) {
+	t.Parallel()
+
+	x := testExceptions(t)
+	testt := []struct {
+		authority string
+		date      time.Time
+		want      string
+	}{
+		{"EX", time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC), "2401"},
+		{"EX", time.Date(2024, time.February, 22, 0, 0, 0, 0, time.UTC), "2401"},
+		{"EX", time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC), "2401"},
+		{"EX", time.Date(2024, time.April, 18, 0, 0, 0, 0, time.UTC), "2404"},
+		{"EX", time.Date(2024, time.May, 16, 0, 0, 0, 0, time.UTC), "2404"},
+		{"EX", time.Date(2024, time.May, 22, 23, 59, 59, 0, time.UTC), "2404"},
+		{"EX", time.Date(2024, time.May, 23, 0, 0, 0, 0, time.UTC), "2405"},
+		{"EX", time.Date(2024, time.June, 13, 0, 0, 0, 0, time.UTC), "2406"},
+		{"EX", time.Date(2024, time.July, 11, 0, 0, 0, 0, time.UTC), "2406"},
+		{"EX", time.Date(2024, time.August, 8, 0, 0, 0, 0, time.UTC), "2408"},
+		{"EY", time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC), "2313"},
+		{"EZ", time.Date(2024, time.February, 22, 0, 0, 0, 0, time.UTC), "2402"},
+	}
+	for _, tt := range testt {
+		if got := x.Operative(tt.authority, tt.date); got.String() != tt.want {
+			t.Errorf("%s %s: Want %s, got %s", tt.authority, tt.date, tt.want, got)
+		}
+	}
+}
+
+func TestExceptionsAuthority(t *testing.T) {
+	t.Parallel()
+
+	x := testExceptions(t)
+	var got []string
+	for _, e := range x.Authority("EX") {
+		got = append(got, e.AIRAC.String()+" "+e.Kind.String())
+	}
+	want := []string{"2402 Skipped", "2403 Skipped", "2405 Postponed", "2407 Replaced"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("Want %v, got %v", want, got)
+	}
+	if len(x.Authority("EZ")) != 0 {
+		t.Error("Want no exceptions of unknown authority")
+	}
+	if ExceptionKind(0).String() != "ExceptionKind(0)" {
+		t.Errorf("Want ExceptionKind(0), got %s", ExceptionKind(0))
+	}
+}
+
+func ExampleExceptions_Operative() {
+	x, _ := NewExceptions(Exception{Authority: "EX", AIRAC: FromStringMust("2402"), Kind: ExceptionSkipped})
+
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	fmt.Println(FromDate(date), x.Operative("EX", date), x.Published("EX", FromDate(date)))
+	// Output: 2402 2401 false
+}
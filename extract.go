@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"sort"
+	"strings"
+)
+
+// Match is an AIRAC identifier found in text. Start and End are the byte
+// offsets of the identifier, i.e. text[Start:End] is the identifier.
+type Match struct {
+	AIRAC AIRAC
+	Start int
+	End   int
+}
+
+// nolint:gochecknoglobals
+var (
+	_extractKeywords   = []string{"airac", "cycles", "cycle", "cyc"}
+	_extractConnectors = []string{"and", "or", "to", "through", "until"}
+)
+
+// ExtractIdentifiers scans text for AIRAC identifiers and returns them in order
+// of their occurrence. To limit false positives, e.g. years or other numbers, a
+// candidate must consist of exactly four digits that form a valid identifier
+// and must follow one of the keywords "AIRAC", "cycle", "cycles" or "cyc"
+// (case-insensitive) or a previous match, e.g. "AIRAC 2313", "cycle: 2401" or
+// "cycles 2301, 2302 and 2305". Keywords and identifiers may be separated by
+// white space and the characters ":#-_=,/" as well as the words "and", "or",
+// "to", "through" and "until". Matches of registered notations with a Regexp
+// (see RegisterNotation) need no keyword.
+func ExtractIdentifiers(text string) []Match {
+	var (
+		matches []Match
+		lastEnd = -1
+	)
+
+	for i := 0; i+4 <= len(text); i++ {
+		if !isDigits(text[i:i+4]) || (i > 0 && isDigit(text[i-1])) ||
+			(i+4 < len(text) && isAlnum(text[i+4])) {
+			continue
+		}
+
+		if !followsKeywordOrMatch(text[:i], lastEnd) {
+			i += 3
+			continue
+		}
+
+		a, err := FromString(text[i : i+4])
+		if err != nil {
+			i += 3
+			continue
+		}
+
+		matches = append(matches, Match{AIRAC: a, Start: i, End: i + 4})
+		lastEnd = i + 4
+		i += 3
+	}
+
+	return mergeMatches(matches, extractNotations(text))
+}
+
+// mergeMatches merges the matches of registered notations into matches in
+// order of occurrence. Of overlapping matches the first and, if they start at
+// the same offset, the longest is kept.
+func mergeMatches(matches, notations []Match) []Match {
+	if len(notations) == 0 {
+		return matches
+	}
+
+	all := append(matches, notations...)
+	sort.SliceStable(all, func(i, j int) bool {
+		if all[i].Start != all[j].Start {
+			return all[i].Start < all[j].Start
+		}
+		return all[i].End > all[j].End
+	})
+
+	merged := all[:0]
+	for _, m := range all {
+		if len(merged) > 0 && m.Start < merged[len(merged)-1].End {
+			continue
+		}
+		merged = append(merged, m)
+	}
+	return merged
+}
+
+// followsKeywordOrMatch reports whether prefix ends with a keyword or with the
+// previous match ending at lastEnd, ignoring separators and connector words.
+// It only looks at the end of prefix, so that scanning stays linear in the
+// length of the text.
+func followsKeywordOrMatch(prefix string, lastEnd int) bool {
+	for {
+		trimmed := strings.TrimRight(prefix, " \t\r\n:#-_=,/")
+		if len(trimmed) == lastEnd {
+			return true
+		}
+
+		for _, kw := range _extractKeywords {
+			if endsWithWord(trimmed, kw) {
+				return true
+			}
+		}
+
+		connector := false
+		for _, c := range _extractConnectors {
+			if endsWithWord(trimmed, c) && len(trimmed) < len(prefix) {
+				prefix = trimmed[:len(trimmed)-len(c)]
+				connector = true
+				break
+			}
+		}
+		if !connector {
+			return false
+		}
+	}
+}
+
+// endsWithWord reports whether s ends with the word w, case-insensitively.
+func endsWithWord(s, w string) bool {
+	i := len(s) - len(w)
+	return i >= 0 && strings.EqualFold(s[i:], w) && isWordStart(s, i)
+}
+
+func isWordStart(s string, i int) bool {
+	return i == 0 || !isAlnum(s[i-1])
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+func isAlnum(b byte) bool {
+	return isDigit(b) || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z')
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExtractIdentifiers(t *testing.T) {
+	t.Parallel()
+
+	testt := []struct {
+		text string
+		want []string
+	}{
+		{"Effective with AIRAC 2313 (2023-12-28).", []string{"2313"}},
+		{"AIRAC2313", []string{"2313"}},
+		{"airac cycle: 2401, build 1234", []string{"2401"}},
+		{"cycles 2301, 2302 and 2305 are archived", []string{"2301", "2302", "2305"}},
+		{"CYC 2201-2203", []string{"2201", "2203"}},
+		{"cycle 2201 to 2203", []string{"2201", "2203"}},
+		{"In 2023 there were 13 cycles.", nil},
+		{"Call 555-1234 about cycle 2314.", nil},
+		{"recycle 2301", nil},
+		{"AIRAC 23013", nil},
+		{"AIRAC 2301x", nil},
+		{"AIRAC 2301 and 2023", []string{"2301"}},
+		{"bicycle and 2301", nil},
+		{"", nil},
+	}
+
+	for _, tt := range testt {
+		matches := ExtractIdentifiers(tt.text)
+		got := make([]string, 0, len(matches))
+		for _, m := range matches {
+			if s := tt.text[m.Start:m.End]; s != m.AIRAC.String() {
+				t.Errorf("%q: offsets [%d:%d] yield %q, want %s", tt.text, m.Start, m.End, s, m.AIRAC)
+			}
+			got = append(got, m.AIRAC.String())
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) && !(len(got) == 0 && len(tt.want) == 0) {
+			t.Errorf("%q: want %v, got %v", tt.text, tt.want, got)
+		}
+	}
+}
+
+func ExampleExtractIdentifiers() {
+	text := "Release notes: data for AIRAC 2313 and 2401 is available."
+	for _, m := range ExtractIdentifiers(text) {
+		fmt.Printf("%s at [%d:%d]\n", m.AIRAC, m.Start, m.End)
+	}
+
+	// Output:
+	// 2313 at [30:34]
+	// 2401 at [39:43]
+}
+
+func TestExtractIdentifiersLargeInput(t *testing.T) {
+	t.Parallel()
+
+	// 1 MiB without matches used to take more than a minute, because every
+	// candidate lowercased the whole text before it.
+	text := strings.Repeat("value 1234 at 2023-12-28; ", 1<<20/26) + "AIRAC 2313"
+
+	start := time.Now()
+	matches := ExtractIdentifiers(text)
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Extracting from %d bytes took %s", len(text), elapsed)
+	}
+	if len(matches) != 1 || matches[0].AIRAC.String() != "2313" {
+		t.Errorf("Want [2313], got %v", matches)
+	}
+}
+
+func BenchmarkExtractIdentifiers(b *testing.B) {
+	text := strings.Repeat("value 1234 at 2023-12-28; ", 1<<16/26) + "AIRAC 2313"
+
+	b.SetBytes(int64(len(text)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ExtractIdentifiers(text)
+	}
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"time"
+)
+
+// nolint:gochecknoglobals
+var _faaCharts = Calendar{epoch: _epoch.Add(cycleDuration), period: 2 * cycleDuration, pivot: 1964}
+
+// ChartCycle represents a 56-day charting cycle of the US Federal Aviation
+// Administration (FAA). Chart cycles become effective on every other AIRAC
+// effective date, e.g. 2024-01-25, and are counted from the first such date
+// after the internal epoch (1901-02-07).
+type ChartCycle uint16
+
+// FromChartDate returns the FAA chart cycle that occurred at date.
+func FromChartDate(date time.Time) ChartCycle {
+	return ChartCycle(_faaCharts.FromDate(date))
+}
+
+// Effective returns the effective date of this chart cycle.
+func (c ChartCycle) Effective() time.Time {
+	return _faaCharts.Effective(AIRAC(c))
+}
+
+// Year returns the year of this chart cycle's effective date.
+func (c ChartCycle) Year() int {
+	return _faaCharts.Year(AIRAC(c))
+}
+
+// Ordinal returns the ordinal of this chart cycle within its year.
+func (c ChartCycle) Ordinal() int {
+	return _faaCharts.Ordinal(AIRAC(c))
+}
+
+// String returns the effective date of this chart cycle. "YYYY-MM-DD"
+func (c ChartCycle) String() string {
+	return c.Effective().Format(format)
+}
+
+// AIRACs returns the AIRAC cycles that fall within this chart cycle in
+// chronological order.
+func (c ChartCycle) AIRACs() []AIRAC {
+	first := AIRAC(c)*2 + 1
+	return []AIRAC{first, first + 1}
+}
+
+// ChartCycle returns the FAA chart cycle this AIRAC cycle falls within. AIRAC
+// cycle 0 precedes the first chart cycle (1901-02-07) and yields chart cycle 0
+// like FromChartDate does for its dates.
+func (a AIRAC) ChartCycle() ChartCycle {
+	if a == 0 {
+		return 0
+	}
+	return ChartCycle((a - 1) / 2)
+}
+
+// FromNASR returns the AIRAC cycle that matches a FAA National Airspace
+// System Resources (NASR) 28-day subscription effective at date, and the offset
+// of date from that cycle's effective date. The matching cycle is the one with
+// the nearest effective date, so the offset lies between -14 days (exclusive)
+// and 14 days (inclusive). Subscriptions aligned to the AIRAC have an offset
+// of zero.
+func FromNASR(effective time.Time) (AIRAC, time.Duration) {
+	a := FromDate(effective)
+	offset := effective.Sub(a.Effective())
+	if offset > cycleDuration/2 {
+		a++
+		offset -= cycleDuration
+	}
+	return a, offset
+}
+
+// NASRAligned reports whether a NASR 28-day subscription effective at date is
+// aligned to the AIRAC, i.e. whether date is an AIRAC effective date.
+func NASRAligned(effective time.Time) bool {
+	_, offset := FromNASR(effective)
+	return offset == 0
+}
+
+// NASREffective returns the effective date of the NASR 28-day subscription
+// that is aligned to this AIRAC cycle.
+func (a AIRAC) NASREffective() time.Time {
+	return a.Effective()
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"testing"
+	"time"
+)
+
+func TestChartCycle(t *testing.T) {
+	t.Parallel()
+
+	testt := []struct {
+		date      string
+		effective string
+		ordinal   int
+		airacs    [2]string
+	}{
+		{"2024-01-25", "2024-01-25", 1, [2]string{"2401", "2402"}},
+		{"2024-03-20", "2024-01-25", 1, [2]string{"2401", "2402"}},
+		{"2024-03-21", "2024-03-21", 2, [2]string{"2403", "2404"}},
+		{"2024-12-26", "2024-12-26", 7, [2]string{"2413", "2501"}},
+		{"2025-01-23", "2024-12-26", 7, [2]string{"2413", "2501"}},
+		{"2025-02-20", "2025-02-20", 1, [2]string{"2502", "2503"}},
+	}
+
+	for _, tt := range testt {
+		date, err := time.Parse(format, tt.date)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		c := FromChartDate(date)
+		if got := c.String(); got != tt.effective {
+			t.Errorf("%s: want chart cycle effective %s, got %s", tt.date, tt.effective, got)
+		}
+		if got := c.Ordinal(); got != tt.ordinal {
+			t.Errorf("%s: want ordinal %d, got %d", tt.date, tt.ordinal, got)
+		}
+
+		airacs := c.AIRACs()
+		if len(airacs) != 2 || airacs[0].String() != tt.airacs[0] || airacs[1].String() != tt.airacs[1] {
+			t.Errorf("%s: want AIRAC cycles %v, got %v", tt.date, tt.airacs, airacs)
+		}
+		for _, a := range airacs {
+			if got := a.ChartCycle(); got != c {
+				t.Errorf("%s: AIRAC %s should fall within chart cycle %s, got %s", tt.date, a, c, got)
+			}
+		}
+	}
+}
+
+func TestChartCycleEpoch(t *testing.T) {
+	t.Parallel()
+
+	// AIRAC cycle 0 (1901-01-10) precedes chart cycle 0 (1901-02-07).
+	if got := AIRAC(0).ChartCycle(); got != 0 {
+		t.Errorf("Want chart cycle 0, got %d", uint16(got))
+	}
+	if got := FromChartDate(AIRAC(0).Effective()); got != AIRAC(0).ChartCycle() {
+		t.Errorf("Want chart cycle %d, got %d", uint16(AIRAC(0).ChartCycle()), uint16(got))
+	}
+	if got := AIRAC(1).ChartCycle(); got != 0 || got.String() != "1901-02-07" {
+		t.Errorf("Want chart cycle 0 (1901-02-07), got %d (%s)", uint16(got), got)
+	}
+	if got := AIRAC(3).ChartCycle(); got != 1 {
+		t.Errorf("Want chart cycle 1, got %d", uint16(got))
+	}
+}
+
+func TestFromNASR(t *testing.T) {
+	t.Parallel()
+
+	testt := []struct {
+		date    string
+		airac   string
+		offset  time.Duration
+		aligned bool
+	}{
+		{"2023-11-30", "2312", 0, true},
+		{"2023-12-01", "2312", 24 * time.Hour, false},
+		{"2023-11-29", "2312", -24 * time.Hour, false},
+		{"2023-12-14", "2312", 14 * 24 * time.Hour, false},
+		{"2023-12-15", "2313", -13 * 24 * time.Hour, false},
+	}
+
+	for _, tt := range testt {
+		date, err := time.Parse(format, tt.date)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		a, offset := FromNASR(date)
+		if a.String() != tt.airac || offset != tt.offset {
+			t.Errorf("%s: want %s offset %s, got %s offset %s", tt.date, tt.airac, tt.offset, a, offset)
+		}
+		if got := NASRAligned(date); got != tt.aligned {
+			t.Errorf("%s: want aligned %t, got %t", tt.date, tt.aligned, got)
+		}
+		if tt.aligned && !a.NASREffective().Equal(date) {
+			t.Errorf("%s: want NASR effective date %s, got %s", tt.date, tt.date, a.NASREffective())
+		}
+	}
+}
@@ -0,0 +1,247 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FilenameTemplate renders file names or paths that are stamped with an AIRAC
+// cycle and extracts the cycle back out of such names. A template consists of
+// literal text and tokens in curly braces:
+//
+//	{yyoo}              identifier, e.g. "2313"
+//	{yyyy}              four-digit year, e.g. "2023"
+//	{yy}                two-digit year, e.g. "23"
+//	{oo}                two-digit ordinal, e.g. "13"
+//	{serial}            serial number since the internal epoch, e.g. "1604"
+//	{effective:LAYOUT}  effective date formatted with a time layout
+//	{expires:LAYOUT}    expiry date formatted with a time layout
+//
+// The layout of the date tokens defaults to "2006-01-02". Example:
+// "navdata_{yyoo}_{effective:20060102}.zip".
+type FilenameTemplate struct {
+	template string
+	parts    []templatePart
+	re       *regexp.Regexp
+
+	// identifies reports whether the tokens identify a cycle.
+	identifies bool
+}
+
+type templatePart struct {
+	literal string
+	token   string
+	layout  string
+}
+
+// NewFilenameTemplate compiles a file name template. The tokens of the
+// template must identify a cycle, i.e. it must contain one of the tokens
+// {yyoo}, {serial}, {effective} or {expires}, or {oo} together with {yy} or
+// {yyyy}.
+func NewFilenameTemplate(template string) (*FilenameTemplate, error) {
+	t, err := compileTemplate(template)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.checkIdentifies(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// compileTemplate compiles a template like NewFilenameTemplate, but accepts
+// tokens that do not identify a cycle, e.g. for formatting only.
+func compileTemplate(template string) (*FilenameTemplate, error) {
+	t := &FilenameTemplate{template: template}
+
+	var (
+		re     strings.Builder
+		tokens = make(map[string]bool)
+		rest   = template
+	)
+	re.WriteString("^")
+	for rest != "" {
+		open := strings.IndexAny(rest, "{}")
+		if open < 0 {
+			t.parts = append(t.parts, templatePart{literal: rest})
+			re.WriteString(regexp.QuoteMeta(rest))
+			break
+		}
+		if rest[open] == '}' {
+			return nil, fmt.Errorf("illegal file name template %q: unexpected '}'", template)
+		}
+		if open > 0 {
+			t.parts = append(t.parts, templatePart{literal: rest[:open]})
+			re.WriteString(regexp.QuoteMeta(rest[:open]))
+		}
+		rest = rest[open+1:]
+
+		end := strings.IndexByte(rest, '}')
+		if end < 0 {
+			return nil, fmt.Errorf("illegal file name template %q: missing '}'", template)
+		}
+		part, pattern, err := parseTemplateToken(rest[:end])
+		if err != nil {
+			return nil, fmt.Errorf("illegal file name template %q: %w", template, err)
+		}
+		t.parts = append(t.parts, part)
+		tokens[part.token] = true
+		re.WriteString(pattern)
+		rest = rest[end+1:]
+	}
+	re.WriteString("$")
+
+	t.identifies = tokens["yyoo"] || tokens["serial"] || tokens["effective"] || tokens["expires"] ||
+		(tokens["oo"] && (tokens["yy"] || tokens["yyyy"]))
+	t.re = regexp.MustCompile(re.String())
+	return t, nil
+}
+
+// checkIdentifies returns an error if the tokens of this template do not
+// identify a cycle, so that file names cannot be parsed.
+func (t *FilenameTemplate) checkIdentifies() error {
+	if !t.identifies {
+		return fmt.Errorf("illegal file name template %q: tokens do not identify a cycle", t.template)
+	}
+	return nil
+}
+
+func parseTemplateToken(token string) (part templatePart, pattern string, err error) {
+	name, layout := token, ""
+	if i := strings.IndexByte(token, ':'); i >= 0 {
+		name, layout = token[:i], token[i+1:]
+	}
+
+	switch name {
+	case "yyoo", "yyyy":
+		pattern = `(\d{4})`
+	case "yy", "oo":
+		pattern = `(\d{2})`
+	case "serial":
+		pattern = `(\d+)`
+	case "effective", "expires":
+		if layout == "" {
+			layout = format
+		}
+		pattern = `(.+?)`
+	default:
+		return templatePart{}, "", fmt.Errorf("unknown token {%s}", token)
+	}
+
+	if layout != "" && name != "effective" && name != "expires" {
+		return templatePart{}, "", fmt.Errorf("token {%s} does not take a layout", name)
+	}
+
+	return templatePart{token: name, layout: layout}, pattern, nil
+}
+
+// String returns the source text of this template.
+func (t *FilenameTemplate) String() string {
+	return t.template
+}
+
+// Format renders the file name of AIRAC cycle a.
+func (t *FilenameTemplate) Format(a AIRAC) string {
+	var b strings.Builder
+	for _, p := range t.parts {
+		switch p.token {
+		case "":
+			b.WriteString(p.literal)
+		case "yyoo":
+			b.WriteString(a.String())
+		case "yyyy":
+			fmt.Fprintf(&b, "%04d", a.Year())
+		case "yy":
+			fmt.Fprintf(&b, "%02d", a.Year()%100)
+		case "oo":
+			fmt.Fprintf(&b, "%02d", a.Ordinal())
+		case "serial":
+			b.WriteString(strconv.Itoa(int(a)))
+		case "effective":
+			b.WriteString(a.Effective().Format(p.layout))
+		case "expires":
+			b.WriteString((a + 1).Effective().Add(-1).Format(p.layout))
+		}
+	}
+	return b.String()
+}
+
+// Parse extracts the AIRAC cycle from a file name rendered by this template.
+// All tokens of the name must consistently denote the same cycle.
+func (t *FilenameTemplate) Parse(name string) (AIRAC, error) {
+	if err := t.checkIdentifies(); err != nil {
+		return 0, err
+	}
+
+	m := t.re.FindStringSubmatch(name)
+	if m == nil {
+		return 0, fmt.Errorf("file name %q does not match template %q", name, t.template)
+	}
+
+	var (
+		values    = make(map[string]string)
+		matchIdx  = 1
+		candidate AIRAC
+		found     bool
+	)
+	for _, p := range t.parts {
+		if p.token == "" {
+			continue
+		}
+		value := m[matchIdx]
+		matchIdx++
+		values[p.token] = value
+
+		if found {
+			continue
+		}
+		switch p.token {
+		case "yyoo":
+			if a, err := FromString(value); err == nil {
+				candidate, found = a, true
+			}
+		case "serial":
+			if n, err := strconv.ParseUint(value, 10, 16); err == nil {
+				candidate, found = AIRAC(n), true
+			}
+		case "effective", "expires":
+			if date, err := time.Parse(p.layout, value); err == nil {
+				candidate, found = FromDate(date), true
+			}
+		}
+	}
+
+	if !found && values["oo"] != "" {
+		if yy := values["yy"]; yy != "" {
+			a, err := FromString(yy + values["oo"])
+			candidate, found = a, err == nil
+		} else if yyyy, err := strconv.Atoi(values["yyyy"]); err == nil {
+			ordinal, _ := strconv.Atoi(values["oo"])
+			candidate, found = _icao.fromYearOrdinal(yyyy, ordinal)
+		}
+	}
+
+	if !found || t.Format(candidate) != name {
+		return 0, fmt.Errorf("file name %q does not denote an AIRAC cycle", name)
+	}
+	return candidate, nil
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"testing"
+)
+
+func TestFilenameTemplate(t *testing.T) {
+	t.Parallel()
+
+	testt := []struct {
+		template string
+		airac    string
+		name     string
+	}{
+		{"navdata_{yyoo}_{effective:20060102}.zip", "2313", "navdata_2313_20231228.zip"},
+		{"{yyyy}/{oo}/cifp.zip", "2101", "2021/01/cifp.zip"},
+		{"AIRAC{yy}{oo}", "9801", "AIRAC9801"},
+		{"d{serial}.dat", "2313", "d1604.dat"},
+		{"{effective}--{expires}.txt", "2014", "2020-12-31--2021-01-27.txt"},
+		{"{effective:02-Jan-2006}_{expires:02-Jan-2006}", "2014", "31-Dec-2020_27-Jan-2021"},
+		{"[{yyoo}].(x)", "2313", "[2313].(x)"},
+	}
+
+	for _, tt := range testt {
+		tmpl, err := NewFilenameTemplate(tt.template)
+		if err != nil {
+			t.Errorf("Template %q did not compile: %v", tt.template, err)
+			continue
+		}
+
+		a := FromStringMust(tt.airac)
+		if got := tmpl.Format(a); got != tt.name {
+			t.Errorf("Template %q: want %q, got %q", tt.template, tt.name, got)
+		}
+
+		got, err := tmpl.Parse(tt.name)
+		if err != nil {
+			t.Errorf("Template %q: %v", tt.template, err)
+			continue
+		}
+		if got != a {
+			t.Errorf("Template %q: want %s, got %s", tt.template, a, got)
+		}
+	}
+}
+
+func TestFilenameTemplateParseMismatch(t *testing.T) {
+	t.Parallel()
+
+	tmpl, err := NewFilenameTemplate("navdata_{yyoo}_{effective:20060102}.zip")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{
+		"navdata_2313_20231229.zip",
+		"navdata_2314_20231228.zip",
+		"navdata_2313_2023122.zip",
+		"navdata_2313.zip",
+		"other_2313_20231228.zip",
+	} {
+		if got, err := tmpl.Parse(name); err == nil {
+			t.Errorf("Name %q yields %s, but should have raised an error", name, got)
+		}
+	}
+}
+
+func TestNewFilenameTemplateIllegal(t *testing.T) {
+	t.Parallel()
+
+	for _, template := range []string{
+		"navdata.zip",
+		"{yyyy}.zip",
+		"{oo}.zip",
+		"{yyoo",
+		"yyoo}",
+		"{foo}{yyoo}",
+		"{yyoo:2006}",
+	} {
+		if _, err := NewFilenameTemplate(template); err == nil {
+			t.Errorf("Template %q should have raised an error", template)
+		}
+	}
+}
+
+func TestFilenameTemplateFormatOnly(t *testing.T) {
+	t.Parallel()
+
+	tmpl, err := compileTemplate("AIRAC {yyyy}/{oo}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := tmpl.Format(FromStringMust("2313")), "AIRAC 2023/13"; got != want {
+		t.Errorf("Want %q, got %q", want, got)
+	}
+
+	tmpl, err = compileTemplate("{yyyy}.zip")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, err := tmpl.Parse("2023.zip"); err == nil {
+		t.Errorf("Parsing with a template that does not identify a cycle yields %s, but should have raised an error", a)
+	}
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"time"
+)
+
+// FuncMap returns template functions for text/template and html/template,
+// e.g. template.New("").Funcs(airac.FuncMap()):
+//
+//	airacNow                     the current cycle
+//	airacParse "2313"            the cycle of an identifier like FromString
+//	airacEffective CYCLE         the effective date of a cycle
+//	airacFormat TEMPLATE CYCLE   a cycle rendered with the tokens of
+//	                             FilenameTemplate, e.g.
+//	                             {{airacNow | airacFormat "{yyoo} ({effective:02 Jan 2006})"}}
+//	                             or {{airacNow | airacFormat "{yyyy}"}}
+//	airacSchedule YEAR           the Details of all cycles of a year
+func FuncMap() map[string]interface{} {
+	return map[string]interface{}{
+		"airacNow":       Current,
+		"airacParse":     FromString,
+		"airacEffective": func(a AIRAC) time.Time { return a.Effective() },
+		"airacFormat":    formatTemplate,
+		"airacSchedule":  Schedule,
+	}
+}
+
+func formatTemplate(template string, a AIRAC) (string, error) {
+	t, err := compileTemplate(template)
+	if err != nil {
+		return "", err
+	}
+	return t.Format(a), nil
+}
+
+// Schedule returns the Details of all AIRAC cycles that become effective in
+// year in chronological order.
+func Schedule(year int) []Details {
+	n := CyclesInYear(year)
+	first := FromDate(time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)) - AIRAC(n-1)
+
+	schedule := make([]Details, n)
+	for i := range schedule {
+		schedule[i] = (first + AIRAC(i)).Details()
+	}
+	return schedule
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+)
+
+func TestFuncMap(t *testing.T) {
+	t.Parallel()
+
+	const src = `{{with airacParse "2313"}}{{airacFormat "{yyoo} ({effective:02 Jan 2006})" .}} {{(airacEffective .).Weekday}}{{end}}` +
+		`{{range airacSchedule 2020}} {{.Identifier}}{{end}}`
+
+	var b strings.Builder
+	tmpl := template.Must(template.New("").Funcs(FuncMap()).Parse(src))
+	if err := tmpl.Execute(&b, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "2313 (28 Dec 2023) Thursday 2001 2002 2003 2004 2005 2006 2007 2008 2009 2010 2011 2012 2013 2014"
+	if got := b.String(); got != want {
+		t.Errorf("Want %q, got %q", want, got)
+	}
+
+	b.Reset()
+	html := htmltemplate.Must(htmltemplate.New("").Funcs(FuncMap()).Parse(`{{airacNow}}`))
+	if err := html.Execute(&b, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := FromString(b.String()); err != nil {
+		t.Errorf("airacNow yields %q: %v", b.String(), err)
+	}
+
+	for _, illegal := range []string{`{{airacParse "2314"}}`, `{{airacNow | airacFormat "{nope}"}}`} {
+		tmpl := template.Must(template.New("").Funcs(FuncMap()).Parse(illegal))
+		if err := tmpl.Execute(new(strings.Builder), nil); err == nil {
+			t.Errorf("Template %s should have raised an error", illegal)
+		}
+	}
+}
+
+func TestFuncMapFormatPartial(t *testing.T) {
+	t.Parallel()
+
+	const src = `{{with airacParse "2313"}}{{airacFormat "{yyyy}" .}} {{airacFormat "{oo}" .}} {{airacFormat "AIRAC" .}}{{end}}`
+
+	var b strings.Builder
+	tmpl := template.Must(template.New("").Funcs(FuncMap()).Parse(src))
+	if err := tmpl.Execute(&b, nil); err != nil {
+		t.Fatal(err)
+	}
+	if want := "2023 13 AIRAC"; b.String() != want {
+		t.Errorf("Want %q, got %q", want, b.String())
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	t.Parallel()
+
+	for year, n := range map[int]int{1998: 14, 2020: 14, 2023: 13} {
+		s := Schedule(year)
+		if len(s) != n {
+			t.Errorf("%d: want %d cycles, got %d", year, n, len(s))
+			continue
+		}
+		for i, d := range s {
+			if d.Year != year || d.Ordinal != i+1 {
+				t.Errorf("%d: unexpected cycle %+v at %d", year, d, i)
+			}
+		}
+	}
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"time"
+)
+
+// EffectiveWeekday is the weekday all AIRAC cycles become effective on.
+const EffectiveWeekday = time.Thursday
+
+// NearestEffectiveDate returns the AIRAC effective date nearest to date and
+// the distance of date from it, i.e. date minus the effective date. The
+// distance lies between -14 days (exclusive) and 14 days (inclusive).
+func NearestEffectiveDate(date time.Time) (time.Time, time.Duration) {
+	a, distance := FromNASR(date)
+	return a.Effective(), distance
+}
+
+// IsEffectiveDate reports whether date is exactly an AIRAC effective date,
+// i.e. midnight UTC of a Thursday on the 28-day grid.
+func IsEffectiveDate(date time.Time) bool {
+	_, distance := NearestEffectiveDate(date)
+	return distance == 0
+}
+
+// CheckEffectiveDate returns an error that describes by how much date is off
+// the AIRAC grid, or nil if date is an AIRAC effective date. It is meant to
+// verify the declared effective dates of third party data.
+func CheckEffectiveDate(date time.Time) error {
+	nearest, distance := NearestEffectiveDate(date)
+	if distance == 0 {
+		return nil
+	}
+
+	if weekday := date.UTC().Weekday(); weekday != EffectiveWeekday {
+		return fmt.Errorf("%s is a %s, not an AIRAC effective date (nearest %s, off by %s)",
+			date.Format(time.RFC3339), weekday, nearest.Format(format), distance)
+	}
+	return fmt.Errorf("%s is not an AIRAC effective date (nearest %s, off by %s)",
+		date.Format(time.RFC3339), nearest.Format(format), distance)
+}
+
+// TruncateToCycle returns the effective date of the AIRAC cycle that is
+// effective at date, i.e. date rounded down to a cycle boundary, e.g. for
+// AIRAC aligned aggregation windows. The result is in UTC.
+func TruncateToCycle(date time.Time) time.Time {
+	return FromDate(date).Effective()
+}
+
+// RoundToCycle returns date rounded to the nearest cycle boundary like
+// NearestEffectiveDate, i.e. halfway values, exactly 14 days after a
+// boundary, are rounded down. The result is in UTC.
+func RoundToCycle(date time.Time) time.Time {
+	nearest, _ := NearestEffectiveDate(date)
+	return nearest
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestNearestEffectiveDate(t *testing.T) {
+	t.Parallel()
+
+	testt := []struct {
+		date     string
+		nearest  string
+		distance time.Duration
+	}{
+		{"2023-12-28T00:00:00Z", "2023-12-28", 0},
+		{"2023-12-27T00:00:00Z", "2023-12-28", -24 * time.Hour},
+		{"2023-12-29T12:00:00Z", "2023-12-28", 36 * time.Hour},
+		{"2024-01-11T00:00:00Z", "2023-12-28", 14 * 24 * time.Hour},
+		{"2024-01-11T00:00:01Z", "2024-01-25", -14*24*time.Hour + time.Second},
+		{"2023-12-28T01:00:00+02:00", "2023-12-28", -time.Hour},
+	}
+	for _, tt := range testt {
+		date, err := time.Parse(time.RFC3339, tt.date)
+		if err != nil {
+			t.Fatal(err)
+		}
+		nearest, distance := NearestEffectiveDate(date)
+		if got := nearest.Format(format); got != tt.nearest {
+			t.Errorf("%s: want nearest %s, got %s", tt.date, tt.nearest, got)
+		}
+		if distance != tt.distance {
+			t.Errorf("%s: want distance %s, got %s", tt.date, tt.distance, distance)
+		}
+		if IsEffectiveDate(date) != (tt.distance == 0) {
+			t.Errorf("%s: IsEffectiveDate should be %t", tt.date, tt.distance == 0)
+		}
+		if err := CheckEffectiveDate(date); (err == nil) != (tt.distance == 0) {
+			t.Errorf("%s: unexpected result of CheckEffectiveDate: %v", tt.date, err)
+		}
+	}
+}
+
+func TestEffectiveWeekday(t *testing.T) {
+	t.Parallel()
+
+	for a := FromStringMust("6401"); a <= FromStringMust("6313"); a++ {
+		if wd := a.Effective().Weekday(); wd != EffectiveWeekday {
+			t.Fatalf("%s: want %s, got %s", a.LongString(), EffectiveWeekday, wd)
+		}
+	}
+}
+
+func ExampleCheckEffectiveDate() {
+	declared := time.Date(2023, time.December, 27, 0, 0, 0, 0, time.UTC)
+	fmt.Println(CheckEffectiveDate(declared))
+	fmt.Println(CheckEffectiveDate(declared.AddDate(0, 0, 1)))
+	// Output:
+	// 2023-12-27T00:00:00Z is a Wednesday, not an AIRAC effective date (nearest 2023-12-28, off by -24h0m0s)
+	// <nil>
+}
+
+func TestTruncateRoundToCycle(t *testing.T) {
+	t.Parallel()
+
+	testt := []struct {
+		date     string
+		truncate string
+		round    string
+	}{
+		{"2023-12-28T00:00:00Z", "2023-12-28", "2023-12-28"},
+		{"2023-12-27T23:59:59Z", "2023-11-30", "2023-12-28"},
+		{"2024-01-10T23:59:59Z", "2023-12-28", "2023-12-28"},
+		{"2024-01-11T00:00:00Z", "2023-12-28", "2023-12-28"},
+		{"2024-01-11T00:00:00.000000001Z", "2023-12-28", "2024-01-25"},
+		{"2024-01-11T00:30:00+01:00", "2023-12-28", "2023-12-28"},
+		{"2024-01-11T01:30:00+01:00", "2023-12-28", "2024-01-25"},
+	}
+	for _, tt := range testt {
+		date, err := time.Parse(time.RFC3339Nano, tt.date)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if nearest, _ := NearestEffectiveDate(date); !RoundToCycle(date).Equal(nearest) {
+			t.Errorf("%s: RoundToCycle and NearestEffectiveDate disagree", tt.date)
+		}
+		if got := TruncateToCycle(date).Format(format); got != tt.truncate {
+			t.Errorf("%s: want truncated %s, got %s", tt.date, tt.truncate, got)
+		}
+		if got := RoundToCycle(date).Format(format); got != tt.round {
+			t.Errorf("%s: want rounded %s, got %s", tt.date, tt.round, got)
+		}
+	}
+}
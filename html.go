@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"html/template"
+	"io"
+	"time"
+)
+
+// Deadline is an additional column of an HTML schedule that shows a date
+// relative to the effective date of each cycle, e.g. the publication date 42
+// days in advance:
+//
+//	airac.Deadline{Name: "Publication", Before: 42 * 24 * time.Hour}
+type Deadline struct {
+	Name   string
+	Before time.Duration
+}
+
+// HTMLSchedule renders AIRAC cycles as an HTML table with the columns
+// identifier, effective date, expiry date (the last day of the cycle) and one
+// column per deadline. The zero value renders the dates in Layout
+// "02 Jan 2006" and without caption.
+type HTMLSchedule struct {
+	Caption   string
+	Layout    string
+	Deadlines []Deadline
+}
+
+type htmlRow struct {
+	Identifier string
+	Effective  string
+	Expires    string
+	Deadlines  []string
+}
+
+// nolint:gochecknoglobals
+var htmlScheduleTemplate = template.Must(template.New("schedule").Parse(`<table class="airac-schedule">
+{{- with .Caption}}
+<caption>{{.}}</caption>
+{{- end}}
+<thead>
+<tr><th>AIRAC</th><th>Effective</th><th>Expires</th>{{range .Deadlines}}<th>{{.Name}}</th>{{end}}</tr>
+</thead>
+<tbody>
+{{- range .Rows}}
+<tr><td>{{.Identifier}}</td><td>{{.Effective}}</td><td>{{.Expires}}</td>{{range .Deadlines}}<td>{{.}}</td>{{end}}</tr>
+{{- end}}
+</tbody>
+</table>
+`))
+
+// Render writes the table of all cycles of r to w.
+func (s HTMLSchedule) Render(w io.Writer, r Range) error {
+	layout := s.Layout
+	if layout == "" {
+		layout = "02 Jan 2006"
+	}
+
+	rows := make([]htmlRow, 0, r.Len())
+	for i := 0; i < r.Len(); i++ {
+		a := r.First + AIRAC(i)
+		effective := a.Effective()
+		row := htmlRow{
+			Identifier: a.String(),
+			Effective:  effective.Format(layout),
+			Expires:    (a + 1).Effective().Add(-1).Format(layout),
+		}
+		for _, d := range s.Deadlines {
+			row.Deadlines = append(row.Deadlines, effective.Add(-d.Before).Format(layout))
+		}
+		rows = append(rows, row)
+	}
+
+	return htmlScheduleTemplate.Execute(w, struct {
+		Caption   string
+		Deadlines []Deadline
+		Rows      []htmlRow
+	}{s.Caption, s.Deadlines, rows})
+}
+
+// RenderYear writes the table of all cycles that become effective in year
+// to w.
+func (s HTMLSchedule) RenderYear(w io.Writer, year int) error {
+	last := FromDate(time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC))
+	return s.Render(w, Range{First: last - AIRAC(CyclesInYear(year)-1), Last: last})
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHTMLScheduleRenderYear(t *testing.T) {
+	t.Parallel()
+
+	var b strings.Builder
+	s := HTMLSchedule{
+		Caption:   "AIRAC <2020>",
+		Layout:    "2006-01-02",
+		Deadlines: []Deadline{{Name: "Publication", Before: 42 * 24 * time.Hour}},
+	}
+	if err := s.RenderYear(&b, 2020); err != nil {
+		t.Fatal(err)
+	}
+	html := b.String()
+
+	if n := strings.Count(html, "<tr><td>"); n != 14 {
+		t.Errorf("Want 14 rows, got %d", n)
+	}
+	for _, want := range []string{
+		"<caption>AIRAC &lt;2020&gt;</caption>",
+		"<th>Publication</th>",
+		"<tr><td>2001</td><td>2020-01-02</td><td>2020-01-29</td><td>2019-11-21</td></tr>",
+		"<tr><td>2014</td><td>2020-12-31</td><td>2021-01-27</td><td>2020-11-19</td></tr>",
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Want %q in\n%s", want, html)
+		}
+	}
+}
+
+func TestHTMLScheduleEmpty(t *testing.T) {
+	t.Parallel()
+
+	var b strings.Builder
+	if err := (HTMLSchedule{}).Render(&b, Range{First: 1, Last: 0}); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(b.String(), "<td>") || strings.Contains(b.String(), "<caption>") {
+		t.Errorf("Want an empty table, got\n%s", b.String())
+	}
+}
+
+func ExampleHTMLSchedule() {
+	r, _ := RangeFromString("2401-2402")
+	_ = HTMLSchedule{}.Render(os.Stdout, r)
+	// Output:
+	// <table class="airac-schedule">
+	// <thead>
+	// <tr><th>AIRAC</th><th>Effective</th><th>Expires</th></tr>
+	// </thead>
+	// <tbody>
+	// <tr><td>2401</td><td>25 Jan 2024</td><td>21 Feb 2024</td></tr>
+	// <tr><td>2402</td><td>22 Feb 2024</td><td>20 Mar 2024</td></tr>
+	// </tbody>
+	// </table>
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+)
+
+// AIRACIdentifier is an AIRAC cycle that is serialized as its identifier
+// "YYOO", e.g. "2313", rather than as its serial number like AIRAC. This is
+// the serialized form described by JSONSchema. Use it for fields of APIs and
+// documents that are meant to be read by humans.
+type AIRACIdentifier AIRAC
+
+// AIRAC returns the AIRAC cycle of this value.
+func (i AIRACIdentifier) AIRAC() AIRAC {
+	return AIRAC(i)
+}
+
+// String returns the identifier of this AIRAC cycle like AIRAC.String.
+func (i AIRACIdentifier) String() string {
+	return AIRAC(i).String()
+}
+
+// MarshalText implements encoding.TextMarshaler with the identifier of this
+// AIRAC cycle. It returns an error if the identifier does not round trip, see
+// AIRAC.Validate.
+func (i AIRACIdentifier) MarshalText() ([]byte, error) {
+	if err := AIRAC(i).Validate(); err != nil {
+		return nil, err
+	}
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler like FromString.
+func (i *AIRACIdentifier) UnmarshalText(text []byte) error {
+	airac, err := FromBytes(text)
+	if err != nil {
+		return err
+	}
+	*i = AIRACIdentifier(airac)
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts the identifier as a
+// JSON string and, for data that was encoded as AIRAC, the serial number as a
+// JSON number. Like for other Go values, JSON null is a no-op.
+func (i *AIRACIdentifier) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.Equal(data, []byte("null")):
+		return nil
+	case len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"':
+		id, err := strconv.Unquote(string(data))
+		if err != nil {
+			return fmt.Errorf("illegal AIRAC id %s", data)
+		}
+		return i.UnmarshalText([]byte(id))
+	default:
+		serial, err := strconv.ParseUint(string(data), 10, 16)
+		if err != nil {
+			return fmt.Errorf("illegal AIRAC id %s", data)
+		}
+		airac, err := FromSerial(uint16(serial))
+		if err != nil {
+			return err
+		}
+		*i = AIRACIdentifier(airac)
+		return nil
+	}
+}
@@ -0,0 +1,103 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAIRACIdentifierJSON(t *testing.T) {
+	t.Parallel()
+
+	type doc struct {
+		Cycle AIRACIdentifier `json:"cycle"`
+	}
+
+	b, err := json.Marshal(doc{Cycle: AIRACIdentifier(FromStringMust("2313"))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"cycle":"2313"}`; string(b) != want {
+		t.Errorf("Want %s, got %s", want, b)
+	}
+
+	for _, data := range []string{`{"cycle":"2313"}`, `{"cycle":1604}`, `{"cycle": " 2313 "}`} {
+		var got doc
+		if err := json.Unmarshal([]byte(data), &got); err != nil || got.Cycle.String() != "2313" {
+			t.Errorf("%s: want 2313, got %s (%v)", data, got.Cycle, err)
+		}
+	}
+
+	for _, data := range []string{`{"cycle":"2314"}`, `{"cycle":-1}`, `{"cycle":65536}`, `{"cycle":true}`, `{"cycle":"231"}`} {
+		var got doc
+		if err := json.Unmarshal([]byte(data), &got); err == nil {
+			t.Errorf("%s yields %s, but should have raised an error", data, got.Cycle)
+		}
+	}
+
+	got := doc{Cycle: AIRACIdentifier(FromStringMust("2313"))}
+	if err := json.Unmarshal([]byte(`{"cycle":null}`), &got); err != nil || got.Cycle.String() != "2313" {
+		t.Errorf("null: want 2313 unchanged, got %s (%v)", got.Cycle, err)
+	}
+}
+
+func TestAIRACIdentifierMarshalTextInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, a := range []AIRAC{0, 3811, AIRAC(MaxSerial) + 1} {
+		if b, err := AIRACIdentifier(a).MarshalText(); err == nil {
+			t.Errorf("Serial %d yields %s, but should have raised an error", uint16(a), b)
+		}
+	}
+}
+
+func TestAIRACIdentifierMapKey(t *testing.T) {
+	t.Parallel()
+
+	in := map[AIRACIdentifier]int{AIRACIdentifier(FromStringMust("2313")): 1}
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"2313":1}`; string(b) != want {
+		t.Errorf("Want %s, got %s", want, b)
+	}
+
+	var out map[AIRACIdentifier]int
+	if err := json.Unmarshal(b, &out); err != nil || out[AIRACIdentifier(FromStringMust("2313"))] != 1 {
+		t.Errorf("Want %v, got %v (%v)", in, out, err)
+	}
+}
+
+func TestAIRACSerialJSON(t *testing.T) {
+	t.Parallel()
+
+	// AIRAC itself keeps the encoding of its underlying type.
+	b, err := json.Marshal(FromStringMust("2313"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "1604" {
+		t.Errorf("Want 1604, got %s", b)
+	}
+
+	var a AIRAC
+	if err := json.Unmarshal(b, &a); err != nil || a.String() != "2313" {
+		t.Errorf("Want 2313, got %s (%v)", a, err)
+	}
+}
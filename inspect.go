@@ -0,0 +1,179 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Artifact is a cycle-stamped file found by an Inspector. If the file looks
+// like a cycle-stamped artifact but the cycle could not be determined, Err
+// describes the problem and AIRAC is zero.
+type Artifact struct {
+	Path  string
+	AIRAC AIRAC
+	Err   error
+}
+
+// Inventory reports the cycle-stamped artifacts found by an Inspector.
+type Inventory struct {
+	// Artifacts are all artifacts in lexical order of their paths.
+	Artifacts []Artifact
+
+	// Cycles are the distinct AIRAC cycles of all valid artifacts in
+	// chronological order.
+	Cycles []AIRAC
+}
+
+// Newest returns the newest installed AIRAC cycle. It returns false if there
+// are no cycles installed.
+func (inv Inventory) Newest() (AIRAC, bool) {
+	if len(inv.Cycles) == 0 {
+		return 0, false
+	}
+	return inv.Cycles[len(inv.Cycles)-1], true
+}
+
+// Contains reports whether AIRAC cycle a is installed.
+func (inv Inventory) Contains(a AIRAC) bool {
+	i := sort.Search(len(inv.Cycles), func(i int) bool { return inv.Cycles[i] >= a })
+	return i < len(inv.Cycles) && inv.Cycles[i] == a
+}
+
+// HasCurrent reports whether the AIRAC cycle effective at date is installed.
+func (inv Inventory) HasCurrent(date time.Time) bool {
+	return inv.Contains(FromDate(date))
+}
+
+// Invalid returns the artifacts whose cycle could not be determined.
+func (inv Inventory) Invalid() []Artifact {
+	var invalid []Artifact
+	for _, a := range inv.Artifacts {
+		if a.Err != nil {
+			invalid = append(invalid, a)
+		}
+	}
+	return invalid
+}
+
+// Inspector detects cycle-stamped artifacts in a file system. Files named
+// cycle.json are read with FromCycleJSON, X-Plane navdata files (earth_*.dat)
+// with ReadXPlane. Other files are matched against Templates, first by their
+// slash-separated path relative to the root and then by their base name. Files
+// that match a template but do not denote a valid cycle are reported as
+// invalid.
+type Inspector struct {
+	Templates []*FilenameTemplate
+}
+
+// InspectDir inspects the directory tree rooted at dir with the zero
+// Inspector, i.e. it detects navdata metadata files only.
+func InspectDir(dir string) (Inventory, error) {
+	return Inspector{}.Inspect(os.DirFS(dir))
+}
+
+// Inspect walks fsys and reports the cycle-stamped artifacts found. An error is
+// only returned if fsys cannot be walked; files that cannot be read or parsed
+// are reported as invalid artifacts.
+func (in Inspector) Inspect(fsys fs.FS) (Inventory, error) {
+	var inv Inventory
+
+	err := fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		if artifact, ok := in.inspectFile(fsys, p); ok {
+			inv.Artifacts = append(inv.Artifacts, artifact)
+		}
+		return nil
+	})
+	if err != nil {
+		return Inventory{}, err
+	}
+
+	seen := make(map[AIRAC]bool)
+	for _, a := range inv.Artifacts {
+		if a.Err == nil && !seen[a.AIRAC] {
+			seen[a.AIRAC] = true
+			inv.Cycles = append(inv.Cycles, a.AIRAC)
+		}
+	}
+	sort.Sort(ByChrono(inv.Cycles))
+
+	return inv, nil
+}
+
+func (in Inspector) inspectFile(fsys fs.FS, p string) (Artifact, bool) {
+	base := path.Base(p)
+
+	switch {
+	case base == "cycle.json":
+		data, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return Artifact{Path: p, Err: err}, true
+		}
+		a, err := FromCycleJSON(data)
+		return newArtifact(p, a, err), true
+
+	case strings.HasPrefix(base, "earth_") && strings.HasSuffix(base, ".dat"):
+		f, err := fsys.Open(p)
+		if err != nil {
+			return Artifact{Path: p, Err: err}, true
+		}
+		defer f.Close()
+		a, err := ReadXPlane(f)
+		return newArtifact(p, a, err), true
+	}
+
+	var invalid error
+	for _, t := range in.Templates {
+		for _, name := range []string{p, base} {
+			if !t.re.MatchString(name) {
+				continue
+			}
+			a, err := t.Parse(name)
+			if err == nil {
+				return Artifact{Path: p, AIRAC: a}, true
+			}
+			if invalid == nil {
+				invalid = err
+			}
+		}
+	}
+
+	if invalid != nil {
+		return Artifact{Path: p, Err: invalid}, true
+	}
+	return Artifact{}, false
+}
+
+func newArtifact(p string, a AIRAC, err error) Artifact {
+	if err != nil {
+		return Artifact{Path: p, Err: fmt.Errorf("%s: %w", p, err)}
+	}
+	return Artifact{Path: p, AIRAC: a}
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+func TestInspector(t *testing.T) {
+	t.Parallel()
+
+	tmpl, err := NewFilenameTemplate("navdata_{yyoo}.zip")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fsys := fstest.MapFS{
+		"xp/Custom Data/cycle.json":      {Data: []byte(`{"cycle": "2313", "revision": "1"}`)},
+		"xp/Custom Data/earth_nav.dat":   {Data: []byte("I\n1150 Version - data cycle 2313, build 20231116\n99\n")},
+		"xp/Custom Data/earth_fix.dat":   {Data: []byte("I\n1150 Version - build 20231116\n99\n")},
+		"archive/navdata_2312.zip":       {},
+		"archive/navdata_2401.zip":       {},
+		"archive/navdata_2414.zip":       {},
+		"archive/readme.txt":             {Data: []byte("AIRAC 2201")},
+		"efb/broken/cycle.json":          {Data: []byte(`{"cycle":`)},
+		"efb/current/navdata_2313.zip":   {},
+		"efb/current/navdata_2313.zip.1": {},
+	}
+
+	inv, err := Inspector{Templates: []*FilenameTemplate{tmpl}}.Inspect(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(inv.Artifacts), 8; got != want {
+		t.Errorf("Want %d artifacts, got %d: %+v", want, got, inv.Artifacts)
+	}
+	if got, want := len(inv.Invalid()), 3; got != want {
+		t.Errorf("Want %d invalid artifacts, got %d: %+v", want, got, inv.Invalid())
+	}
+
+	if got, want := fmt.Sprint(inv.Cycles), "[2312 2313 2401]"; got != want {
+		t.Errorf("Want cycles %s, got %s", want, got)
+	}
+	if newest, ok := inv.Newest(); !ok || newest.String() != "2401" {
+		t.Errorf("Want newest 2401, got %s", newest)
+	}
+	if !inv.HasCurrent(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("Cycle 2313 should be current at 2024-01-01")
+	}
+	if inv.HasCurrent(time.Date(2024, time.February, 22, 0, 0, 0, 0, time.UTC)) {
+		t.Error("Cycle 2402 should not be installed")
+	}
+}
+
+func TestInspectorEmpty(t *testing.T) {
+	t.Parallel()
+
+	inv, err := Inspector{}.Inspect(fstest.MapFS{"readme.txt": {}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := inv.Newest(); ok || len(inv.Artifacts) != 0 {
+		t.Errorf("Want empty inventory, got %+v", inv)
+	}
+}
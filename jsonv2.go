@@ -0,0 +1,43 @@
+//go:build goexperiment.jsonv2
+
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"encoding/json/jsontext"
+)
+
+// MarshalJSONTo implements encoding/json/v2.MarshalerTo with the same
+// representation as MarshalText, e.g. "2313".
+func (i AIRACIdentifier) MarshalJSONTo(enc *jsontext.Encoder) error {
+	text, err := i.MarshalText()
+	if err != nil {
+		return err
+	}
+	return enc.WriteToken(jsontext.String(string(text)))
+}
+
+// UnmarshalJSONFrom implements encoding/json/v2.UnmarshalerFrom like
+// UnmarshalJSON.
+func (i *AIRACIdentifier) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	val, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return i.UnmarshalJSON(val)
+}
@@ -0,0 +1,76 @@
+//go:build goexperiment.jsonv2
+
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	jsonv1 "encoding/json"
+	"encoding/json/v2"
+	"testing"
+)
+
+func TestJSONv2(t *testing.T) {
+	t.Parallel()
+
+	type doc struct {
+		Cycle  AIRACIdentifier   `json:"cycle"`
+		Cycles []AIRACIdentifier `json:"cycles"`
+	}
+	in := doc{
+		Cycle:  AIRACIdentifier(FromStringMust("2313")),
+		Cycles: []AIRACIdentifier{AIRACIdentifier(FromStringMust("2401")), AIRACIdentifier(FromStringMust("2402"))},
+	}
+
+	v2, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v1, err := jsonv1.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"cycle":"2313","cycles":["2401","2402"]}`; string(v2) != want || string(v1) != want {
+		t.Errorf("Want %s, got %s (v2) and %s (v1)", want, v2, v1)
+	}
+
+	var out doc
+	if err := json.Unmarshal(v2, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Cycle != in.Cycle || len(out.Cycles) != 2 || out.Cycles[1] != in.Cycles[1] {
+		t.Errorf("Want %v, got %v", in, out)
+	}
+
+	for _, data := range []string{`"2314"`, `65536`, `true`, `"231"`} {
+		var a AIRACIdentifier
+		if err := json.Unmarshal([]byte(data), &a); err == nil {
+			t.Errorf("%s yields %s, but should have raised an error", data, a)
+		}
+	}
+
+	var a AIRACIdentifier
+	if err := json.Unmarshal([]byte(`"2313"`), &a); err != nil || a.String() != "2313" {
+		t.Errorf("Want 2313, got %s (%v)", a, err)
+	}
+	if err := json.Unmarshal([]byte(`1604`), &a); err != nil || a.String() != "2313" {
+		t.Errorf("Want 2313, got %s (%v)", a, err)
+	}
+	if _, err := json.Marshal(AIRACIdentifier(0)); err == nil {
+		t.Error("Marshaling serial 0 should have raised an error")
+	}
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// julianUnixEpoch is the Julian Date of 1970-01-01 00:00 UTC.
+	julianUnixEpoch = 2440587.5
+
+	// mjdUnixEpoch is the Modified Julian Day of 1970-01-01.
+	mjdUnixEpoch = 40587
+
+	secondsPerDay = 24 * 60 * 60
+)
+
+// JulianDate returns the Julian Date of the effective date of this AIRAC
+// cycle. Effective dates are at midnight UTC, so the fraction is always .5.
+func (a AIRAC) JulianDate() float64 {
+	return julianUnixEpoch + float64(a.Effective().Unix())/secondsPerDay
+}
+
+// MJD returns the Modified Julian Day of the effective date of this AIRAC
+// cycle, i.e. the Julian Date minus 2400000.5.
+func (a AIRAC) MJD() int {
+	return mjdUnixEpoch + int(a.Effective().Unix()/secondsPerDay)
+}
+
+// FromJulianDate returns the AIRAC cycle that is effective at the Julian Date
+// jd.
+func FromJulianDate(jd float64) AIRAC {
+	days, frac := math.Modf(jd - julianUnixEpoch)
+	if frac < 0 {
+		days, frac = days-1, frac+1
+	}
+	date := time.Unix(int64(days)*secondsPerDay, 0).Add(time.Duration(frac * float64(24*time.Hour)))
+	return FromDate(date)
+}
+
+// FromMJD returns the AIRAC cycle that is effective on the Modified Julian Day
+// mjd.
+func FromMJD(mjd int) AIRAC {
+	return FromDate(time.Unix(int64(mjd-mjdUnixEpoch)*secondsPerDay, 0))
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestJulian(t *testing.T) {
+	t.Parallel()
+
+	testt := []struct {
+		id  string
+		jd  float64
+		mjd int
+	}{
+		{"2401", 2460334.5, 60334},
+		{"6401", 2438410.5, 38410},
+		{"7001", 2440594.5, 40594},
+		{"6313", 2474894.5, 74894},
+	}
+	for _, tt := range testt {
+		a := FromStringMust(tt.id)
+		if got := a.JulianDate(); got != tt.jd {
+			t.Errorf("%s: want JD %f, got %f", tt.id, tt.jd, got)
+		}
+		if got := a.MJD(); got != tt.mjd {
+			t.Errorf("%s: want MJD %d, got %d", tt.id, tt.mjd, got)
+		}
+		if got := FromJulianDate(tt.jd); got != a {
+			t.Errorf("%f: want %s, got %s", tt.jd, a, got)
+		}
+		if got := FromJulianDate(tt.jd + 27.99); got != a {
+			t.Errorf("%f: want %s, got %s", tt.jd+27.99, a, got)
+		}
+		if got := FromJulianDate(tt.jd - 0.01); got != a-1 {
+			t.Errorf("%f: want %s, got %s", tt.jd-0.01, a-1, got)
+		}
+		if got := FromMJD(tt.mjd); got != a {
+			t.Errorf("%d: want %s, got %s", tt.mjd, a, got)
+		}
+		if got := FromMJD(tt.mjd - 1); got != a-1 {
+			t.Errorf("%d: want %s, got %s", tt.mjd-1, a-1, got)
+		}
+	}
+}
+
+func ExampleAIRAC_MJD() {
+	a := FromStringMust("2401")
+	fmt.Println(a.MJD(), a.JulianDate())
+	// Output: 60334 2.4603345e+06
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ListFormat configures ParseList and FormatList.
+type ListFormat struct {
+	// Separator separates the items of a list. If empty, "," is used.
+	Separator string
+
+	// Ranges enables items "YYOO-YYOO" that denote all cycles from the first
+	// to the last cycle inclusive.
+	Ranges bool
+}
+
+func (f ListFormat) separator() string {
+	if f.Separator == "" {
+		return ","
+	}
+	return f.Separator
+}
+
+// ParseList returns the cycles of a delimited list of identifiers like
+// "2301,2302,2305" or, with ranges, "2301-2303,2305", e.g. from a config file
+// or an HTTP query parameter. The cycles are returned in order of the list,
+// ranges expanded in chronological order. White space around items is
+// ignored. The empty string denotes the empty list.
+func ParseList(s string, f ListFormat) ([]AIRAC, error) {
+	sep := f.separator()
+	if f.Ranges && strings.Contains(sep, "-") {
+		return nil, fmt.Errorf("illegal AIRAC list separator %q", sep)
+	}
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	var cycles []AIRAC
+	for _, item := range strings.Split(s, sep) {
+		item = strings.TrimSpace(item)
+		if f.Ranges && strings.Contains(item, "-") {
+			r, err := RangeFromString(item)
+			if err != nil {
+				return nil, fmt.Errorf("illegal AIRAC list %q: %w", s, err)
+			}
+			for a := r.First; a <= r.Last; a++ {
+				cycles = append(cycles, a)
+			}
+			continue
+		}
+
+		a, err := FromString(item)
+		if err != nil {
+			return nil, fmt.Errorf("illegal AIRAC list %q: %w", s, err)
+		}
+		cycles = append(cycles, a)
+	}
+	return cycles, nil
+}
+
+// FormatList returns the delimited list of the identifiers of cycles in order.
+// With ranges, runs of consecutive cycles in chronological order are
+// abbreviated as "YYOO-YYOO".
+func FormatList(cycles []AIRAC, f ListFormat) string {
+	var (
+		b   strings.Builder
+		sep = f.separator()
+	)
+	for i := 0; i < len(cycles); i++ {
+		if i > 0 {
+			b.WriteString(sep)
+		}
+		b.WriteString(cycles[i].String())
+
+		if !f.Ranges {
+			continue
+		}
+		j := i
+		for j+1 < len(cycles) && cycles[j+1] == cycles[j]+1 {
+			j++
+		}
+		if j > i {
+			b.WriteString("-")
+			b.WriteString(cycles[j].String())
+			i = j
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParseList(t *testing.T) {
+	t.Parallel()
+
+	ranges := ListFormat{Ranges: true}
+	testt := []struct {
+		s    string
+		f    ListFormat
+		want string
+		ok   bool
+	}{
+		{"2301,2302,2305", ListFormat{}, "2301 2302 2305", true},
+		{" 2305 , 2301 ", ListFormat{}, "2305 2301", true},
+		{"2301;2302", ListFormat{Separator: ";"}, "2301 2302", true},
+		{"2313-2402,2301", ranges, "2313 2401 2402 2301", true},
+		{"2301 2303-2303", ListFormat{Separator: " ", Ranges: true}, "2301 2303", true},
+		{"2301-2302", ListFormat{Separator: "-"}, "2301 2302", true},
+		{"", ranges, "", true},
+		{"2301-2303", ListFormat{}, "", false},
+		{"2303-2301", ranges, "", false},
+		{"2301,,2302", ListFormat{}, "", false},
+		{"2301,", ListFormat{}, "", false},
+		{"2314", ListFormat{}, "", false},
+		{"2301", ListFormat{Separator: "-", Ranges: true}, "", false},
+	}
+	for _, tt := range testt {
+		got, err := ParseList(tt.s, tt.f)
+		if tt.ok != (err == nil) {
+			t.Errorf("%q %+v: want ok %t, got %v", tt.s, tt.f, tt.ok, err)
+			continue
+		}
+		if s := FormatList(got, ListFormat{Separator: " "}); tt.ok && s != tt.want {
+			t.Errorf("%q %+v: want %s, got %s", tt.s, tt.f, tt.want, s)
+		}
+	}
+}
+
+func TestFormatList(t *testing.T) {
+	t.Parallel()
+
+	cycles, err := ParseList("2301,2302,2303,2305,2313,2401,2303", ListFormat{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testt := []struct {
+		f    ListFormat
+		want string
+	}{
+		{ListFormat{}, "2301,2302,2303,2305,2313,2401,2303"},
+		{ListFormat{Separator: "|"}, "2301|2302|2303|2305|2313|2401|2303"},
+		{ListFormat{Ranges: true}, "2301-2303,2305,2313-2401,2303"},
+	}
+	for _, tt := range testt {
+		got := FormatList(cycles, tt.f)
+		if got != tt.want {
+			t.Errorf("%+v: want %s, got %s", tt.f, tt.want, got)
+		}
+		if back, err := ParseList(got, tt.f); err != nil || len(back) != len(cycles) {
+			t.Errorf("%+v: round trip of %s failed: %v (%v)", tt.f, got, back, err)
+		}
+	}
+	if got := FormatList(nil, ListFormat{}); got != "" {
+		t.Errorf("Want the empty string, got %q", got)
+	}
+}
+
+func ExampleParseList() {
+	cycles, _ := ParseList("2301-2303, 2305", ListFormat{Ranges: true})
+	fmt.Println(cycles)
+	fmt.Println(FormatList(cycles, ListFormat{Separator: ";"}))
+	// Output:
+	// [2301 2302 2303 2305]
+	// 2301;2302;2303;2305
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DaysPerCycle is the number of days of an AIRAC cycle.
+const DaysPerCycle = int(cycleDuration / (24 * time.Hour))
+
+// nolint:gochecknoglobals
+var _moment = regexp.MustCompile(`(?i)^(\d{4})\s+day\s+(\d{1,2})$`)
+
+// Moment is a day within an AIRAC cycle, for systems that date events relative
+// to the start of the cycle rather than by the calendar. Day 1 is the
+// effective date of the cycle, day 28 its last day.
+type Moment struct {
+	AIRAC AIRAC
+	Day   int
+}
+
+// MomentOf returns the day within the AIRAC cycle that is effective at date.
+func MomentOf(date time.Time) Moment {
+	a := FromDate(date)
+	return Moment{AIRAC: a, Day: int(date.Sub(a.Effective())/(24*time.Hour)) + 1}
+}
+
+// MomentFromString returns the moment that matches the representation
+// "YYOO day D" of Moment.String.
+func MomentFromString(s string) (Moment, error) {
+	m := _moment.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return Moment{}, fmt.Errorf("illegal AIRAC moment %q", s)
+	}
+
+	airac, err := FromString(m[1])
+	if err != nil {
+		return Moment{}, err
+	}
+	day, _ := strconv.Atoi(m[2])
+	if day < 1 || day > DaysPerCycle {
+		return Moment{}, fmt.Errorf("illegal AIRAC moment %q", s)
+	}
+
+	return Moment{AIRAC: airac, Day: day}, nil
+}
+
+// Time returns the start of the day of this moment, i.e. midnight UTC.
+func (m Moment) Time() time.Time {
+	return m.AIRAC.Effective().Add(time.Duration(m.Day-1) * 24 * time.Hour)
+}
+
+// String returns a short representation of this moment. "YYOO day D"
+func (m Moment) String() string {
+	return fmt.Sprintf("%s day %d", m.AIRAC, m.Day)
+}
+
+// Before reports whether this moment precedes o.
+func (m Moment) Before(o Moment) bool {
+	if m.AIRAC != o.AIRAC {
+		return m.AIRAC < o.AIRAC
+	}
+	return m.Day < o.Day
+}
+
+// ByMoment is an []Moment wrapper, that satisfies sort.Interface and can be
+// used to chronologically sort Moment instances.
+type ByMoment []Moment
+
+// Len ist the number of elements in the collection.
+func (c ByMoment) Len() int { return len(c) }
+
+// Less reports whether the element with index i should sort before the element
+// with index j.
+func (c ByMoment) Less(i, j int) bool { return c[i].Before(c[j]) }
+
+// Swap swaps the elements with indexes i and j.
+func (c ByMoment) Swap(i, j int) { c[i], c[j] = c[j], c[i] }
+
+// static assert
+var _ sort.Interface = (ByMoment)(nil)
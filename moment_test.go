@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestMoment(t *testing.T) {
+	t.Parallel()
+
+	testt := []struct {
+		date string
+		want string
+		day  string
+	}{
+		{"2023-12-28T00:00:00Z", "2313 day 1", "2023-12-28"},
+		{"2024-01-01T13:37:00Z", "2313 day 5", "2024-01-01"},
+		{"2024-01-24T23:59:59Z", "2313 day 28", "2024-01-24"},
+		{"2024-01-25T00:00:00Z", "2401 day 1", "2024-01-25"},
+		{"2024-01-25T00:30:00+01:00", "2313 day 28", "2024-01-24"},
+	}
+	for _, tt := range testt {
+		date, err := time.Parse(time.RFC3339, tt.date)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m := MomentOf(date)
+		if m.String() != tt.want {
+			t.Errorf("%s: want %s, got %s", tt.date, tt.want, m)
+		}
+		if got := m.Time().Format(format); got != tt.day {
+			t.Errorf("%s: want day %s, got %s", tt.date, tt.day, got)
+		}
+		if back, err := MomentFromString(m.String()); err != nil || back != m {
+			t.Errorf("%s: want %s, got %s (%v)", tt.date, m, back, err)
+		}
+	}
+}
+
+func TestMomentFromStringIllegal(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{"", "2313", "2313 day 0", "2313 day 29", "2314 day 1", "2313 day -1", "2313day5"} {
+		if m, err := MomentFromString(s); err == nil {
+			t.Errorf("%q yields %s, but should have raised an error", s, m)
+		}
+	}
+	if m, err := MomentFromString(" 2313 DAY 05 "); err != nil || m.String() != "2313 day 5" {
+		t.Errorf("Want 2313 day 5, got %s (%v)", m, err)
+	}
+}
+
+func TestByMoment(t *testing.T) {
+	t.Parallel()
+
+	moments := []Moment{
+		{FromStringMust("2401"), 1},
+		{FromStringMust("2313"), 28},
+		{FromStringMust("2313"), 2},
+	}
+	sort.Sort(ByMoment(moments))
+	if fmt.Sprint(moments) != "[2313 day 2 2313 day 28 2401 day 1]" {
+		t.Errorf("Unexpected order %v", moments)
+	}
+	if DaysPerCycle != 28 {
+		t.Errorf("Want 28 days per cycle, got %d", DaysPerCycle)
+	}
+}
@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"time"
+)
+
+// XPlaneHeaderLines is the number of leading lines of an X-Plane navdata file
+// that ReadXPlane searches for the version header.
+const XPlaneHeaderLines = 3
+
+// nolint:gochecknoglobals
+var _xplaneCycle = regexp.MustCompile(`(?i)\bdata cycle\s+(\d{4})\b`)
+
+// FromXPlaneHeader returns the AIRAC cycle that an X-Plane navdata file (e.g.
+// earth_nav.dat, earth_fix.dat) declares in its version header line, e.g.
+// "1150 Version - data cycle 2313, build 20231116, metadata NavXP1150.".
+func FromXPlaneHeader(line string) (AIRAC, error) {
+	m := _xplaneCycle.FindStringSubmatch(line)
+	if m == nil {
+		return 0, fmt.Errorf("missing data cycle in X-Plane header %q", line)
+	}
+
+	airac, err := FromString(m[1])
+	if err != nil {
+		return 0, fmt.Errorf("illegal X-Plane header: %w", err)
+	}
+	return airac, nil
+}
+
+// ReadXPlane reads the version header of an X-Plane navdata file within the
+// first XPlaneHeaderLines lines and returns the declared AIRAC cycle. See
+// CompareNavdata for how far it lags behind the current cycle.
+func ReadXPlane(r io.Reader) (AIRAC, error) {
+	scanner := bufio.NewScanner(r)
+	for line := 0; line < XPlaneHeaderLines && scanner.Scan(); line++ {
+		if _xplaneCycle.MatchString(scanner.Text()) {
+			return FromXPlaneHeader(scanner.Text())
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	return 0, fmt.Errorf("missing X-Plane header")
+}
+
+// FromCycleJSON returns the AIRAC cycle that a cycle.json navdata metadata
+// file declares in its "cycle" member, e.g. {"cycle": "2313", "revision": "1"}.
+// The cycle may be given as a string or as a number like for FromInt, e.g. 101
+// for "0101". See CompareNavdata for how far it lags behind the current cycle.
+func FromCycleJSON(data []byte) (AIRAC, error) {
+	var meta struct {
+		Cycle json.RawMessage `json:"cycle"`
+	}
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return 0, fmt.Errorf("illegal cycle.json: %w", err)
+	}
+	if len(meta.Cycle) == 0 {
+		return 0, fmt.Errorf("missing cycle in cycle.json")
+	}
+
+	var (
+		airac AIRAC
+		err   error
+	)
+	if meta.Cycle[0] == '"' {
+		var id string
+		if err := json.Unmarshal(meta.Cycle, &id); err != nil {
+			return 0, fmt.Errorf("illegal cycle.json: %w", err)
+		}
+		airac, err = FromString(id)
+	} else {
+		// A number has lost the leading zero of years like 2001, e.g. 101.
+		var yyoo int
+		if err := json.Unmarshal(meta.Cycle, &yyoo); err != nil {
+			return 0, fmt.Errorf("illegal cycle.json: illegal cycle %s", meta.Cycle)
+		}
+		airac, err = FromInt(yyoo)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("illegal cycle.json: %w", err)
+	}
+	return airac, nil
+}
+
+// NavdataCycle is the result of CompareNavdata.
+type NavdataCycle struct {
+	// Declared is the AIRAC cycle the navdata declares.
+	Declared AIRAC
+	// Current is the AIRAC cycle effective at the time of the comparison.
+	Current AIRAC
+	// Lag is the number of cycles Declared lags behind Current, i.e. the Age
+	// of Declared. It is negative for navdata of a future cycle.
+	Lag int
+}
+
+// Outdated reports whether the navdata lags behind the current cycle.
+func (n NavdataCycle) Outdated() bool {
+	return n.Lag > 0
+}
+
+// CompareNavdata compares the AIRAC cycle declared by navdata, e.g. as returned
+// by ReadXPlane or FromCycleJSON, with the cycle effective at now. Use a
+// StalenessPolicy to decide whether the lag is acceptable.
+func CompareNavdata(declared AIRAC, now time.Time) NavdataCycle {
+	return NavdataCycle{Declared: declared, Current: FromDate(now), Lag: declared.Age(now)}
+}
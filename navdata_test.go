@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFromXPlaneHeader(t *testing.T) {
+	t.Parallel()
+
+	testt := []struct {
+		line  string
+		airac string
+		valid bool
+	}{
+		{"1150 Version - data cycle 2313, build 20231116, metadata NavXP1150.", "2313", true},
+		{"1100 Version - DATA CYCLE 1611, build 20161026, metadata NavXP1100. Copyright", "1611", true},
+		{"1100 Version - data cycle 1614, build 20161026", "", false},
+		{"1100 Version - data cycle 16111", "", false},
+		{"1100 Version - build 20161026", "", false},
+	}
+
+	for _, tt := range testt {
+		got, err := FromXPlaneHeader(tt.line)
+		if tt.valid != (err == nil) {
+			t.Errorf("%q: want valid %t, got error %v", tt.line, tt.valid, err)
+			continue
+		}
+		if tt.valid && got.String() != tt.airac {
+			t.Errorf("%q: want %s, got %s", tt.line, tt.airac, got)
+		}
+	}
+}
+
+func TestReadXPlane(t *testing.T) {
+	t.Parallel()
+
+	dat := "I\n1150 Version - data cycle 2313, build 20231116, metadata NavXP1150.\n\n3 KJFK ...\n99\n"
+	got, err := ReadXPlane(strings.NewReader(dat))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.String() != "2313" {
+		t.Errorf("Want 2313, got %s", got)
+	}
+
+	if got, err := ReadXPlane(strings.NewReader("I\n\n\n1150 Version - data cycle 2313\n")); err == nil {
+		t.Errorf("Header beyond line %d yields %s, but should have raised an error", XPlaneHeaderLines, got)
+	}
+}
+
+func TestFromCycleJSON(t *testing.T) {
+	t.Parallel()
+
+	testt := []struct {
+		json  string
+		airac string
+		valid bool
+	}{
+		{`{"cycle": "2313", "revision": "1", "name": "AIRAC cycle 2313"}`, "2313", true},
+		{`{"cycle": 2101}`, "2101", true},
+		{`{"cycle": 101}`, "0101", true},
+		{`{"cycle": 913}`, "0913", true},
+		{`{"cycle": "0913"}`, "0913", true},
+		{`{"cycle": 2101.5}`, "", false},
+		{`{"cycle": -101}`, "", false},
+		{`{"cycle": true}`, "", false},
+		{`{"cycle": null}`, "", false},
+		{`{"cycle": "2314"}`, "", false},
+		{`{"revision": "1"}`, "", false},
+		{`{"cycle": "2313"`, "", false},
+	}
+
+	for _, tt := range testt {
+		got, err := FromCycleJSON([]byte(tt.json))
+		if tt.valid != (err == nil) {
+			t.Errorf("%s: want valid %t, got error %v", tt.json, tt.valid, err)
+			continue
+		}
+		if tt.valid && got.String() != tt.airac {
+			t.Errorf("%s: want %s, got %s", tt.json, tt.airac, got)
+		}
+	}
+}
+
+func TestCompareNavdata(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+	testt := []struct {
+		declared string
+		lag      int
+		outdated bool
+	}{
+		{"2313", 1, true},
+		{"2401", 0, false},
+		{"2402", -1, false},
+		{"2310", 4, true},
+	}
+
+	for _, tt := range testt {
+		got := CompareNavdata(FromStringMust(tt.declared), now)
+		if got.Declared.String() != tt.declared || got.Current.String() != "2401" {
+			t.Errorf("%s: want %s and 2401, got %s and %s", tt.declared, tt.declared, got.Declared, got.Current)
+		}
+		if got.Lag != tt.lag || got.Outdated() != tt.outdated {
+			t.Errorf("%s: want lag %d (outdated %t), got %d (%t)", tt.declared, tt.lag, tt.outdated, got.Lag, got.Outdated())
+		}
+	}
+}
@@ -0,0 +1,213 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Notation is a custom, e.g. house-specific, notation of AIRAC identifiers
+// like "C2313" or "AIRAC-23-13". Registered notations are honored by Parse in
+// ParseLenient mode and, if they have a Regexp, by ExtractIdentifiers.
+type Notation struct {
+	// Name identifies the notation in the registry.
+	Name string
+
+	// Regexp matches the notation. It must have the named groups "yy" and
+	// "oo", "yyyy" and "oo" or "yyoo", e.g. `\bC(?P<yy>\d{2})(?P<oo>\d{2})\b`.
+	// Two-digit years are interpreted like FromString.
+	Regexp *regexp.Regexp
+
+	// Parse parses the notation if Regexp is nil.
+	Parse func(s string) (AIRAC, error)
+
+	// Format formats a cycle in this notation for FormatNotation. It is
+	// optional.
+	Format func(a AIRAC) string
+}
+
+// nolint:gochecknoglobals
+var _notations = struct {
+	sync.RWMutex
+	byName map[string]Notation
+
+	// sorted is the snapshot of byName in order of the names. It is replaced,
+	// not modified, when the registry changes.
+	sorted []Notation
+}{byName: make(map[string]Notation)}
+
+// RegisterNotation registers notation n. It returns an error if the name is
+// empty or already registered, if n has neither a Regexp nor a Parse function
+// or if the Regexp lacks the required groups.
+func RegisterNotation(n Notation) error {
+	if n.Name == "" {
+		return fmt.Errorf("illegal AIRAC notation without name")
+	}
+	if n.Regexp == nil && n.Parse == nil {
+		return fmt.Errorf("illegal AIRAC notation %q without regexp or parse function", n.Name)
+	}
+	if n.Regexp != nil && !hasNotationGroups(n.Regexp) {
+		return fmt.Errorf("illegal AIRAC notation %q: regexp %s lacks the groups yy and oo, yyyy and oo or yyoo", n.Name, n.Regexp)
+	}
+
+	_notations.Lock()
+	defer _notations.Unlock()
+
+	if _, ok := _notations.byName[n.Name]; ok {
+		return fmt.Errorf("AIRAC notation %q already registered", n.Name)
+	}
+	_notations.byName[n.Name] = n
+	snapshotNotations()
+	return nil
+}
+
+// UnregisterNotation removes the notation name from the registry. It reports
+// whether the notation was registered.
+func UnregisterNotation(name string) bool {
+	_notations.Lock()
+	defer _notations.Unlock()
+
+	if _, ok := _notations.byName[name]; !ok {
+		return false
+	}
+	delete(_notations.byName, name)
+	snapshotNotations()
+	return true
+}
+
+// snapshotNotations rebuilds the sorted snapshot of the registry. The caller
+// must hold the write lock.
+func snapshotNotations() {
+	sorted := make([]Notation, 0, len(_notations.byName))
+	for _, n := range _notations.byName {
+		sorted = append(sorted, n)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+	_notations.sorted = sorted
+}
+
+// Notations returns the registered notations in order of their names.
+func Notations() []Notation {
+	return append([]Notation(nil), registeredNotations()...)
+}
+
+// registeredNotations returns the snapshot of the registry. It must not be
+// modified.
+func registeredNotations() []Notation {
+	_notations.RLock()
+	defer _notations.RUnlock()
+	return _notations.sorted
+}
+
+// FormatNotation returns cycle a in the registered notation name.
+func FormatNotation(name string, a AIRAC) (string, error) {
+	_notations.RLock()
+	n, ok := _notations.byName[name]
+	_notations.RUnlock()
+
+	switch {
+	case !ok:
+		return "", fmt.Errorf("unknown AIRAC notation %q", name)
+	case n.Format == nil:
+		return "", fmt.Errorf("AIRAC notation %q cannot format", name)
+	default:
+		return n.Format(a), nil
+	}
+}
+
+func hasNotationGroups(re *regexp.Regexp) bool {
+	groups := make(map[string]bool)
+	for _, name := range re.SubexpNames() {
+		groups[name] = true
+	}
+	return groups["yyoo"] || (groups["oo"] && (groups["yy"] || groups["yyyy"]))
+}
+
+// parseNotations parses s with the registered notations. The regexp of a
+// notation must match s entirely.
+func parseNotations(s string) (AIRAC, bool) {
+	s = strings.TrimSpace(s)
+	for _, n := range registeredNotations() {
+		if n.Regexp == nil {
+			if a, err := n.Parse(s); err == nil {
+				return a, true
+			}
+			continue
+		}
+		if loc := n.Regexp.FindStringSubmatchIndex(s); loc != nil && loc[0] == 0 && loc[1] == len(s) {
+			if a, ok := notationMatch(n.Regexp, s, loc); ok {
+				return a, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// extractNotations returns the matches of the registered notations with a
+// regexp in text.
+func extractNotations(text string) []Match {
+	var matches []Match
+	for _, n := range registeredNotations() {
+		if n.Regexp == nil {
+			continue
+		}
+		for _, loc := range n.Regexp.FindAllStringSubmatchIndex(text, -1) {
+			if a, ok := notationMatch(n.Regexp, text, loc); ok {
+				matches = append(matches, Match{AIRAC: a, Start: loc[0], End: loc[1]})
+			}
+		}
+	}
+	return matches
+}
+
+// notationMatch returns the cycle denoted by the groups of the submatch loc of
+// re in s.
+func notationMatch(re *regexp.Regexp, s string, loc []int) (AIRAC, bool) {
+	group := func(name string) string {
+		i := re.SubexpIndex(name)
+		if i < 0 || loc[2*i] < 0 {
+			return ""
+		}
+		return s[loc[2*i]:loc[2*i+1]]
+	}
+
+	if yyoo := group("yyoo"); yyoo != "" {
+		a, err := Parse(yyoo, ParseStrict)
+		return a, err == nil
+	}
+
+	oo := group("oo")
+	if yy := group("yy"); yy != "" {
+		a, err := Parse(yy+oo, ParseStrict)
+		return a, err == nil
+	}
+
+	year, err := strconv.Atoi(group("yyyy"))
+	if err != nil {
+		return 0, false
+	}
+	ordinal, err := strconv.Atoi(oo)
+	if err != nil {
+		return 0, false
+	}
+	return _icao.fromYearOrdinal(year, ordinal)
+}
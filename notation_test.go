@@ -0,0 +1,185 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// registerTestNotations registers the notations "test-c" ("C2313") and
+// "test-cycle" ("2023/13 cycle") for the duration of test t. Since the
+// registry is global, tests that use it must not run in parallel.
+func registerTestNotations(t *testing.T) {
+	t.Helper()
+
+	for _, n := range []Notation{
+		{
+			Name:   "test-c",
+			Regexp: regexp.MustCompile(`\bC(?P<yy>\d{2})(?P<oo>\d{2})\b`),
+			Format: func(a AIRAC) string { return "C" + a.String() },
+		},
+		{
+			Name: "test-cycle",
+			Parse: func(s string) (AIRAC, error) {
+				var year, ordinal int
+				if _, err := fmt.Sscanf(s, "%d/%d cycle", &year, &ordinal); err != nil {
+					return 0, err
+				}
+				a, ok := _icao.fromYearOrdinal(year, ordinal)
+				if !ok {
+					return 0, fmt.Errorf("illegal AIRAC id %q", s)
+				}
+				return a, nil
+			},
+		},
+	} {
+		if err := RegisterNotation(n); err != nil {
+			t.Fatal(err)
+		}
+		name := n.Name
+		t.Cleanup(func() { UnregisterNotation(name) })
+	}
+}
+
+func TestRegisterNotationError(t *testing.T) {
+	registerTestNotations(t)
+
+	testt := []struct {
+		name     string
+		notation Notation
+	}{
+		{"no name", Notation{Regexp: regexp.MustCompile(`(?P<yyoo>\d{4})`)}},
+		{"no regexp or parse", Notation{Name: "test-empty"}},
+		{"no groups", Notation{Name: "test-groups", Regexp: regexp.MustCompile(`C\d{4}`)}},
+		{"no ordinal", Notation{Name: "test-oo", Regexp: regexp.MustCompile(`C(?P<yy>\d{2})\d{2}`)}},
+		{"duplicate", Notation{Name: "test-c", Regexp: regexp.MustCompile(`(?P<yyoo>\d{4})`)}},
+	}
+
+	for _, tt := range testt {
+		if err := RegisterNotation(tt.notation); err == nil {
+			t.Errorf("Registering notation with %s should have raised an error", tt.name)
+		}
+	}
+}
+
+func TestNotationParse(t *testing.T) {
+	registerTestNotations(t)
+
+	testt := []struct {
+		in   string
+		want string
+	}{
+		{"C2313", "2313"},
+		{" C2401 ", "2401"},
+		{"2023/13 cycle", "2313"},
+		{"AIRAC-23-13", "2313"},
+	}
+
+	for _, tt := range testt {
+		got, err := Parse(tt.in, ParseLenient)
+		if err != nil {
+			t.Errorf("%q: %v", tt.in, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("%q: Want %s, got %s", tt.in, tt.want, got)
+		}
+	}
+
+	for _, in := range []string{"C2314", "C2313x", "xC2313", "2023/14 cycle"} {
+		if _, err := Parse(in, ParseLenient); err == nil {
+			t.Errorf("Parsing %q should have raised an error", in)
+		}
+	}
+
+	if _, err := Parse("C2313", ParseStrict); err == nil {
+		t.Error("Strict parsing of a registered notation should have raised an error")
+	}
+}
+
+func TestNotationExtract(t *testing.T) {
+	registerTestNotations(t)
+
+	matches := ExtractIdentifiers("Loaded C2312, then AIRAC 2313 and C2401; not XC2402.")
+
+	var got []string
+	for _, m := range matches {
+		got = append(got, m.AIRAC.String())
+	}
+	if want := "2312,2313,2401"; strings.Join(got, ",") != want {
+		t.Errorf("Want %s, got %s", want, strings.Join(got, ","))
+	}
+	if len(matches) > 0 && (matches[0].Start != 7 || matches[0].End != 12) {
+		t.Errorf("Want match at [7, 12), got [%d, %d)", matches[0].Start, matches[0].End)
+	}
+}
+
+func TestFormatNotation(t *testing.T) {
+	registerTestNotations(t)
+
+	got, err := FormatNotation("test-c", FromStringMust("2313"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "C2313" {
+		t.Errorf("Want C2313, got %s", got)
+	}
+
+	if _, err := FormatNotation("test-cycle", 0); err == nil {
+		t.Error("Formatting with a notation without Format should have raised an error")
+	}
+	if _, err := FormatNotation("test-unknown", 0); err == nil {
+		t.Error("Formatting with an unknown notation should have raised an error")
+	}
+}
+
+func TestNotations(t *testing.T) {
+	registerTestNotations(t)
+
+	var names []string
+	for _, n := range Notations() {
+		if strings.HasPrefix(n.Name, "test-") {
+			names = append(names, n.Name)
+		}
+	}
+	if want := "test-c,test-cycle"; strings.Join(names, ",") != want {
+		t.Errorf("Want %s, got %s", want, strings.Join(names, ","))
+	}
+}
+
+func TestUnregisterNotation(t *testing.T) {
+	registerTestNotations(t)
+
+	if !UnregisterNotation("test-c") {
+		t.Error("Want test-c unregistered")
+	}
+	if UnregisterNotation("test-c") {
+		t.Error("Want test-c not registered anymore")
+	}
+	if _, err := Parse("C2313", ParseLenient); err == nil {
+		t.Error("Parsing an unregistered notation should have raised an error")
+	}
+	if matches := ExtractIdentifiers("Loaded C2313."); len(matches) != 0 {
+		t.Errorf("Want no matches of an unregistered notation, got %v", matches)
+	}
+	if names := len(Notations()); names != 1 {
+		t.Errorf("Want 1 notation, got %d", names)
+	}
+}
@@ -0,0 +1,42 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"time"
+)
+
+// ActivePair returns the cycle that is effective at now and the following
+// cycle, i.e. the pair of cycles an FMS or EFB data load carries at now.
+func ActivePair(now time.Time) (current, next AIRAC) {
+	current = FromDate(now)
+	return current, current + 1
+}
+
+// InEffect returns which cycle of a loaded pair is in effect at date. The
+// order of the pair does not matter. It reports false if date lies within
+// the validity of neither cycle, e.g. after both have expired.
+func InEffect(a, b AIRAC, date time.Time) (AIRAC, bool) {
+	switch FromDate(date) {
+	case a:
+		return a, true
+	case b:
+		return b, true
+	default:
+		return 0, false
+	}
+}
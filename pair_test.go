@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestActivePair(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.February, 21, 23, 59, 59, 0, time.UTC)
+	current, next := ActivePair(now)
+	if current.String() != "2401" || next.String() != "2402" {
+		t.Errorf("Want 2401 and 2402, got %s and %s", current, next)
+	}
+
+	testt := []struct {
+		date time.Time
+		want string
+		ok   bool
+	}{
+		{current.Effective().Add(-time.Second), "", false},
+		{current.Effective(), "2401", true},
+		{now, "2401", true},
+		{now.Add(time.Second), "2402", true},
+		{next.Effective().AddDate(0, 0, 28), "", false},
+	}
+	for _, tt := range testt {
+		for _, pair := range [][2]AIRAC{{current, next}, {next, current}} {
+			got, ok := InEffect(pair[0], pair[1], tt.date)
+			if ok != tt.ok || (ok && got.String() != tt.want) {
+				t.Errorf("%s: want %s (%t), got %s (%t)", tt.date, tt.want, tt.ok, got, ok)
+			}
+		}
+	}
+}
+
+func ExampleActivePair() {
+	now := time.Date(2024, time.February, 1, 12, 0, 0, 0, time.UTC)
+	current, next := ActivePair(now)
+	fmt.Println(current, next)
+
+	active, _ := InEffect(current, next, time.Date(2024, time.February, 22, 0, 0, 0, 0, time.UTC))
+	fmt.Println(active)
+	// Output:
+	// 2401 2402
+	// 2402
+}
@@ -0,0 +1,151 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ParseMode selects how strictly Parse interprets identifiers.
+type ParseMode int
+
+const (
+	// ParseDefault parses like FromString, i.e. surrounding white space is
+	// ignored.
+	ParseDefault ParseMode = iota
+
+	// ParseStrict accepts exactly four ASCII digits, e.g. for protocols and
+	// validation.
+	ParseStrict
+
+	// ParseLenient accepts human input: surrounding white space, a prefix
+	// "AIRAC", "cycle" or "cyc" (case-insensitive) and a separator between year
+	// and ordinal, e.g. "AIRAC 23/13", "cycle: 23-13" or "2313", as well as
+	// the registered notations (see RegisterNotation).
+	ParseLenient
+)
+
+// nolint:gochecknoglobals
+var _parsePrefixes = []string{"airac", "cycle", "cyc"}
+
+// Parse returns the AIRAC cycle that matches the identifier <yyoo> according
+// to mode. The years are interpreted like FromString.
+func Parse(yyoo string, mode ParseMode) (AIRAC, error) {
+	switch mode {
+	case ParseDefault:
+		return FromString(yyoo)
+	case ParseStrict:
+		if len(yyoo) != 4 || !isDigits(yyoo) {
+			return 0, fmt.Errorf("illegal AIRAC id %q", yyoo)
+		}
+		return FromString(yyoo)
+	case ParseLenient:
+		return parseLenient(yyoo)
+	default:
+		return 0, fmt.Errorf("illegal parse mode %d", int(mode))
+	}
+}
+
+func parseLenient(yyoo string) (AIRAC, error) {
+	s := strings.ToLower(strings.TrimSpace(yyoo))
+	for _, prefix := range _parsePrefixes {
+		if strings.HasPrefix(s, prefix) {
+			s = strings.TrimLeft(s[len(prefix):], " \t:#-_")
+			break
+		}
+	}
+
+	if len(s) == 5 && isDigits(s[:2]) && isDigits(s[3:]) && strings.IndexByte("-/._ ", s[2]) >= 0 {
+		s = s[:2] + s[3:]
+	}
+
+	if len(s) != 4 || !isDigits(s) {
+		if airac, ok := parseNotations(yyoo); ok {
+			return airac, nil
+		}
+		return 0, fmt.Errorf("illegal AIRAC id %q", yyoo)
+	}
+	airac, err := FromString(s)
+	if err != nil {
+		return 0, fmt.Errorf("illegal AIRAC id %q", yyoo)
+	}
+	return airac, nil
+}
+
+// ParseError is the error of parsing the identifier at Index of the input of
+// ParseAll.
+type ParseError struct {
+	Index int
+	Input string
+	Err   error
+}
+
+// Error implements the error interface.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ParseAll parses all identifiers like FromString. It returns the successfully
+// parsed cycles in input order and, if any identifier failed to parse, an error
+// that joins a *ParseError for each failure.
+func ParseAll(ids []string) ([]AIRAC, error) {
+	var (
+		cycles = make([]AIRAC, 0, len(ids))
+		errs   []error
+	)
+	for i, id := range ids {
+		a, err := FromString(id)
+		if err != nil {
+			errs = append(errs, &ParseError{Index: i, Input: id, Err: err})
+			continue
+		}
+		cycles = append(cycles, a)
+	}
+	return cycles, errors.Join(errs...)
+}
+
+// ParseDate parses value with layout like time.Parse and returns the cycle
+// that is effective at the parsed date, e.g. for effective dates given by
+// vendor manifests. Dates without time zone are interpreted as UTC.
+func ParseDate(layout, value string) (AIRAC, error) {
+	date, err := time.Parse(layout, value)
+	if err != nil {
+		return 0, err
+	}
+	return FromDate(date), nil
+}
+
+// ParseDateStrict parses value like ParseDate, but returns an error if the
+// date is not exactly an AIRAC effective date (see CheckEffectiveDate).
+func ParseDateStrict(layout, value string) (AIRAC, error) {
+	date, err := time.Parse(layout, value)
+	if err != nil {
+		return 0, err
+	}
+	if err := CheckEffectiveDate(date); err != nil {
+		return 0, err
+	}
+	return FromDate(date), nil
+}
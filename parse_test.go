@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testt := []struct {
+		s       string
+		strict  bool
+		deflt   bool
+		lenient bool
+	}{
+		{"2313", true, true, true},
+		{" 2313", false, true, true},
+		{"2313\n", false, true, true},
+		{"AIRAC 2313", false, false, true},
+		{"airac2313", false, false, true},
+		{"Cycle: 23-13", false, false, true},
+		{"cyc #2313", false, false, true},
+		{"23/13", false, false, true},
+		{"23.13", false, false, true},
+		{"23 13", false, false, true},
+		{"2314", false, false, false},
+		{"AIRAC 23--13", false, false, false},
+		{"AIRAC 202313", false, false, false},
+		{"+313", false, false, false},
+		{"23-1", false, false, false},
+		{"AIRAC", false, false, false},
+		{"", false, false, false},
+	}
+
+	for _, tt := range testt {
+		for mode, valid := range map[ParseMode]bool{ParseStrict: tt.strict, ParseDefault: tt.deflt, ParseLenient: tt.lenient} {
+			got, err := Parse(tt.s, mode)
+			if valid != (err == nil) {
+				t.Errorf("%q (mode %d): want valid %t, got error %v", tt.s, mode, valid, err)
+				continue
+			}
+			if valid && got.String() != "2313" {
+				t.Errorf("%q (mode %d): want 2313, got %s", tt.s, mode, got)
+			}
+		}
+	}
+
+	if _, err := Parse("2313", ParseMode(42)); err == nil {
+		t.Error("Unknown parse mode should have raised an error")
+	}
+}
+
+func TestParseAll(t *testing.T) {
+	t.Parallel()
+
+	cycles, err := ParseAll([]string{"2301", "nope", "2302", "2314"})
+	if got, want := fmt.Sprint(cycles), "[2301 2302]"; got != want {
+		t.Errorf("Want %s, got %s", want, got)
+	}
+	if err == nil {
+		t.Fatal("Want error, got nil")
+	}
+
+	var indices []int
+	for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var pe *ParseError
+		if !errors.As(e, &pe) {
+			t.Fatalf("Want *ParseError, got %T", e)
+		}
+		indices = append(indices, pe.Index)
+	}
+	if got, want := fmt.Sprint(indices), "[1 3]"; got != want {
+		t.Errorf("Want failed indices %s, got %s", want, got)
+	}
+	t.Logf("ParseAll rightfully yields error: %v", err)
+
+	cycles, err = ParseAll([]string{"2301"})
+	if err != nil || len(cycles) != 1 {
+		t.Errorf("Want [2301] and no error, got %v, %v", cycles, err)
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	t.Parallel()
+
+	testt := []struct {
+		layout string
+		value  string
+		want   string
+		ok     bool
+		strict bool
+	}{
+		{"2006-01-02", "2024-01-25", "2401", true, true},
+		{"2006-01-02", "2024-02-21", "2401", true, false},
+		{"02 Jan 2006", "22 Feb 2024", "2402", true, true},
+		{"20060102", "20240222", "2402", true, true},
+		{time.RFC3339, "2024-02-22T00:00:00Z", "2402", true, true},
+		{time.RFC3339, "2024-02-22T00:00:00+01:00", "2401", true, false},
+		{time.RFC3339, "2024-02-22T00:01:00Z", "2402", true, false},
+		{"2006-01-02", "22 Feb 2024", "", false, false},
+	}
+	for _, tt := range testt {
+		got, err := ParseDate(tt.layout, tt.value)
+		if tt.ok != (err == nil) {
+			t.Errorf("%q: want ok %t, got %v", tt.value, tt.ok, err)
+			continue
+		}
+		if tt.ok && got.String() != tt.want {
+			t.Errorf("%q: want %s, got %s", tt.value, tt.want, got)
+		}
+
+		got, err = ParseDateStrict(tt.layout, tt.value)
+		if tt.strict != (err == nil) {
+			t.Errorf("%q: want strict ok %t, got %v", tt.value, tt.strict, err)
+			continue
+		}
+		if tt.strict && got.String() != tt.want {
+			t.Errorf("%q: want %s, got %s", tt.value, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,12 @@
+module github.com/jwkohnen/airac/pgxairac
+
+go 1.20
+
+require (
+	github.com/jackc/pgx/v5 v5.6.0
+	github.com/jwkohnen/airac v0.0.0-20261014045759-b07acbc8a262
+)
+
+// For development within the repository only; Go ignores replace directives of
+// dependencies, so consumers get the version required above.
+replace github.com/jwkohnen/airac => ../
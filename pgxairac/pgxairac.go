@@ -0,0 +1,221 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pgxairac registers AIRAC cycles with the type map of pgx v5, so
+// that they scan and encode natively when talking to PostgreSQL without
+// database/sql, e.g. from an AfterConnect hook:
+//
+//	config.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+//		pgxairac.Register(conn.TypeMap())
+//		return nil
+//	}
+//
+// Cycles are stored as their identifier "YYOO" in text, varchar and bpchar
+// columns and as their serial number in int2 columns, and []airac.AIRAC maps
+// to the respective array types. Cycles whose identifier does not round trip
+// (see airac.AIRAC.Validate) cannot be encoded as text, and serial numbers
+// above airac.MaxSerial cannot be encoded at all.
+//
+// Package pgxairac is a module of its own, so that the module
+// github.com/jwkohnen/airac does not depend on pgx.
+package pgxairac
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/jwkohnen/airac"
+)
+
+// Register wraps the codecs of the text, varchar, bpchar and int2 types and
+// their array types in m, so that they handle airac.AIRAC in addition to the
+// Go types they handled before. Parameters of unknown type, e.g. with the
+// simple protocol, are encoded as text.
+func Register(m *pgtype.Map) {
+	for _, t := range []struct {
+		oid, arrayOID uint32
+		wrap          func(pgtype.Codec) pgtype.Codec
+	}{
+		{pgtype.TextOID, pgtype.TextArrayOID, func(c pgtype.Codec) pgtype.Codec { return &TextCodec{c} }},
+		{pgtype.VarcharOID, pgtype.VarcharArrayOID, func(c pgtype.Codec) pgtype.Codec { return &TextCodec{c} }},
+		{pgtype.BPCharOID, pgtype.BPCharArrayOID, func(c pgtype.Codec) pgtype.Codec { return &TextCodec{c} }},
+		{pgtype.Int2OID, pgtype.Int2ArrayOID, func(c pgtype.Codec) pgtype.Codec { return &Int2Codec{c} }},
+	} {
+		base, ok := m.TypeForOID(t.oid)
+		if !ok {
+			continue
+		}
+		elem := &pgtype.Type{Name: base.Name, OID: base.OID, Codec: t.wrap(base.Codec)}
+		m.RegisterType(elem)
+
+		if array, ok := m.TypeForOID(t.arrayOID); ok {
+			m.RegisterType(&pgtype.Type{Name: array.Name, OID: array.OID, Codec: &pgtype.ArrayCodec{ElementType: elem}})
+		}
+	}
+
+	m.RegisterDefaultPgType(airac.AIRAC(0), "text")
+	m.RegisterDefaultPgType([]airac.AIRAC(nil), "_text")
+}
+
+// TextCodec is a codec of a textual PostgreSQL type that encodes and scans
+// airac.AIRAC as its identifier and delegates all other values to Codec.
+type TextCodec struct {
+	pgtype.Codec
+}
+
+// PlanEncode implements pgtype.Codec.
+func (c *TextCodec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	if _, ok := value.(airac.AIRAC); ok {
+		// The binary format of textual types is the text itself.
+		return encodePlanText{}
+	}
+	return c.Codec.PlanEncode(m, oid, format, value)
+}
+
+// PlanScan implements pgtype.Codec.
+func (c *TextCodec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	if _, ok := target.(*airac.AIRAC); ok {
+		return scanPlanText{}
+	}
+	return c.Codec.PlanScan(m, oid, format, target)
+}
+
+type encodePlanText struct{}
+
+func (encodePlanText) Encode(value any, buf []byte) ([]byte, error) {
+	text, err := airac.AIRACIdentifier(value.(airac.AIRAC)).MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, text...), nil
+}
+
+type scanPlanText struct{}
+
+func (scanPlanText) Scan(src []byte, target any) error {
+	if src == nil {
+		return fmt.Errorf("cannot scan NULL into %T", target)
+	}
+	a, err := airac.FromBytes(src)
+	if err != nil {
+		return err
+	}
+	*target.(*airac.AIRAC) = a
+	return nil
+}
+
+// Int2Codec is a codec of the PostgreSQL type int2 that encodes and scans
+// airac.AIRAC as its serial number and delegates all other values to Codec.
+type Int2Codec struct {
+	pgtype.Codec
+}
+
+// PlanEncode implements pgtype.Codec.
+func (c *Int2Codec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	if _, ok := value.(airac.AIRAC); ok {
+		switch format {
+		case pgtype.BinaryFormatCode:
+			return encodePlanInt2Binary{}
+		case pgtype.TextFormatCode:
+			return encodePlanInt2Text{}
+		}
+	}
+	return c.Codec.PlanEncode(m, oid, format, value)
+}
+
+// PlanScan implements pgtype.Codec.
+func (c *Int2Codec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	if _, ok := target.(*airac.AIRAC); ok {
+		switch format {
+		case pgtype.BinaryFormatCode:
+			return scanPlanInt2Binary{}
+		case pgtype.TextFormatCode:
+			return scanPlanInt2Text{}
+		}
+	}
+	return c.Codec.PlanScan(m, oid, format, target)
+}
+
+type encodePlanInt2Binary struct{}
+
+func (encodePlanInt2Binary) Encode(value any, buf []byte) ([]byte, error) {
+	serial, err := encodeSerial(value)
+	if err != nil {
+		return nil, err
+	}
+	return binary.BigEndian.AppendUint16(buf, serial), nil
+}
+
+type encodePlanInt2Text struct{}
+
+func (encodePlanInt2Text) Encode(value any, buf []byte) ([]byte, error) {
+	serial, err := encodeSerial(value)
+	if err != nil {
+		return nil, err
+	}
+	return strconv.AppendUint(buf, uint64(serial), 10), nil
+}
+
+// encodeSerial returns the serial number of the airac.AIRAC value. It returns
+// an error if it exceeds airac.MaxSerial, which does not fit int2 and would not
+// scan back.
+func encodeSerial(value any) (uint16, error) {
+	a, err := airac.FromSerial(value.(airac.AIRAC).Serial())
+	if err != nil {
+		return 0, err
+	}
+	return a.Serial(), nil
+}
+
+type scanPlanInt2Binary struct{}
+
+func (scanPlanInt2Binary) Scan(src []byte, target any) error {
+	if src == nil {
+		return fmt.Errorf("cannot scan NULL into %T", target)
+	}
+	if len(src) != 2 {
+		return fmt.Errorf("invalid length for int2: %d", len(src))
+	}
+	return scanSerial(int64(int16(binary.BigEndian.Uint16(src))), target)
+}
+
+type scanPlanInt2Text struct{}
+
+func (scanPlanInt2Text) Scan(src []byte, target any) error {
+	if src == nil {
+		return fmt.Errorf("cannot scan NULL into %T", target)
+	}
+	n, err := strconv.ParseInt(string(src), 10, 16)
+	if err != nil {
+		return fmt.Errorf("illegal AIRAC serial %q", src)
+	}
+	return scanSerial(n, target)
+}
+
+func scanSerial(n int64, target any) error {
+	if n < 0 {
+		return fmt.Errorf("illegal AIRAC serial %d", n)
+	}
+	a, err := airac.FromSerial(uint16(n))
+	if err != nil {
+		return err
+	}
+	*target.(*airac.AIRAC) = a
+	return nil
+}
@@ -0,0 +1,200 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pgxairac
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/jwkohnen/airac"
+)
+
+func newMap() *pgtype.Map {
+	m := pgtype.NewMap()
+	Register(m)
+	return m
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	m := newMap()
+	a := airac.FromStringMust("2313")
+	testt := []struct {
+		oid    uint32
+		format int16
+		want   []byte
+	}{
+		{pgtype.TextOID, pgtype.TextFormatCode, []byte("2313")},
+		{pgtype.TextOID, pgtype.BinaryFormatCode, []byte("2313")},
+		{pgtype.VarcharOID, pgtype.BinaryFormatCode, []byte("2313")},
+		{pgtype.BPCharOID, pgtype.TextFormatCode, []byte("2313")},
+		{pgtype.Int2OID, pgtype.TextFormatCode, []byte("1604")},
+		{pgtype.Int2OID, pgtype.BinaryFormatCode, []byte{0x06, 0x44}},
+	}
+	for _, tt := range testt {
+		buf, err := m.Encode(tt.oid, tt.format, a, nil)
+		if err != nil {
+			t.Errorf("oid %d format %d: %v", tt.oid, tt.format, err)
+			continue
+		}
+		if !bytes.Equal(buf, tt.want) {
+			t.Errorf("oid %d format %d: want %q, got %q", tt.oid, tt.format, tt.want, buf)
+		}
+
+		var got airac.AIRAC
+		if err := m.Scan(tt.oid, tt.format, buf, &got); err != nil || got != a {
+			t.Errorf("oid %d format %d: want %s, got %s (%v)", tt.oid, tt.format, a, got, err)
+		}
+	}
+}
+
+func TestEncodeInvalidIdentifier(t *testing.T) {
+	t.Parallel()
+
+	m := newMap()
+	for _, a := range []airac.AIRAC{0, 3811} {
+		if buf, err := m.Encode(pgtype.TextOID, pgtype.TextFormatCode, a, nil); err == nil {
+			t.Errorf("Serial %d yields %q, but should have raised an error", a.Serial(), buf)
+		}
+		if _, err := m.Encode(pgtype.Int2OID, pgtype.BinaryFormatCode, a, nil); err != nil {
+			t.Errorf("Serial %d: %v", a.Serial(), err)
+		}
+	}
+}
+
+func TestEncodeInvalidSerial(t *testing.T) {
+	t.Parallel()
+
+	m := newMap()
+	for _, a := range []airac.AIRAC{airac.AIRAC(airac.MaxSerial) + 1, 40000} {
+		for _, format := range []int16{pgtype.TextFormatCode, pgtype.BinaryFormatCode} {
+			if buf, err := m.Encode(pgtype.Int2OID, format, a, nil); err == nil {
+				t.Errorf("Serial %d format %d yields %q, but should have raised an error", a.Serial(), format, buf)
+			}
+		}
+	}
+
+	last := airac.AIRAC(airac.MaxSerial)
+	buf, err := m.Encode(pgtype.Int2OID, pgtype.BinaryFormatCode, last, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got airac.AIRAC
+	if err := m.Scan(pgtype.Int2OID, pgtype.BinaryFormatCode, buf, &got); err != nil || got != last {
+		t.Errorf("Want serial %d, got %d (%v)", last.Serial(), got.Serial(), err)
+	}
+}
+
+func TestScanErrors(t *testing.T) {
+	t.Parallel()
+
+	m := newMap()
+	testt := []struct {
+		oid    uint32
+		format int16
+		src    []byte
+	}{
+		{pgtype.TextOID, pgtype.TextFormatCode, nil},
+		{pgtype.TextOID, pgtype.TextFormatCode, []byte("2314")},
+		{pgtype.BPCharOID, pgtype.TextFormatCode, []byte("23")},
+		{pgtype.Int2OID, pgtype.TextFormatCode, nil},
+		{pgtype.Int2OID, pgtype.TextFormatCode, []byte("-1")},
+		{pgtype.Int2OID, pgtype.TextFormatCode, []byte("9999")},
+		{pgtype.Int2OID, pgtype.TextFormatCode, []byte("x")},
+		{pgtype.Int2OID, pgtype.BinaryFormatCode, []byte{0x06}},
+		{pgtype.Int2OID, pgtype.BinaryFormatCode, []byte{0xff, 0xff}},
+	}
+	for _, tt := range testt {
+		var got airac.AIRAC
+		if err := m.Scan(tt.oid, tt.format, tt.src, &got); err == nil {
+			t.Errorf("oid %d format %d src %q yields %s, but should have raised an error", tt.oid, tt.format, tt.src, got)
+		}
+	}
+}
+
+func TestNull(t *testing.T) {
+	t.Parallel()
+
+	m := newMap()
+	got := new(airac.AIRAC)
+	if err := m.Scan(pgtype.TextOID, pgtype.TextFormatCode, nil, &got); err != nil || got != nil {
+		t.Errorf("Want nil, got %v (%v)", got, err)
+	}
+	if err := m.Scan(pgtype.Int2OID, pgtype.BinaryFormatCode, []byte{0x06, 0x44}, &got); err != nil || got == nil || got.String() != "2313" {
+		t.Errorf("Want 2313, got %v (%v)", got, err)
+	}
+}
+
+func TestArrays(t *testing.T) {
+	t.Parallel()
+
+	m := newMap()
+	want := []airac.AIRAC{airac.FromStringMust("2313"), airac.FromStringMust("2401")}
+	for _, oid := range []uint32{pgtype.TextArrayOID, pgtype.VarcharArrayOID, pgtype.Int2ArrayOID} {
+		for _, format := range []int16{pgtype.TextFormatCode, pgtype.BinaryFormatCode} {
+			buf, err := m.Encode(oid, format, want, nil)
+			if err != nil {
+				t.Errorf("oid %d format %d: %v", oid, format, err)
+				continue
+			}
+			var got []airac.AIRAC
+			if err := m.Scan(oid, format, buf, &got); err != nil {
+				t.Errorf("oid %d format %d: %v", oid, format, err)
+				continue
+			}
+			if len(got) != 2 || got[0] != want[0] || got[1] != want[1] {
+				t.Errorf("oid %d format %d: want %v, got %v", oid, format, want, got)
+			}
+		}
+	}
+}
+
+func TestDelegates(t *testing.T) {
+	t.Parallel()
+
+	m := newMap()
+	buf, err := m.Encode(pgtype.TextOID, pgtype.TextFormatCode, "hello", nil)
+	if err != nil || string(buf) != "hello" {
+		t.Errorf("Want hello, got %q (%v)", buf, err)
+	}
+	var s string
+	if err := m.Scan(pgtype.TextOID, pgtype.TextFormatCode, []byte("hello"), &s); err != nil || s != "hello" {
+		t.Errorf("Want hello, got %q (%v)", s, err)
+	}
+	var n int16
+	if err := m.Scan(pgtype.Int2OID, pgtype.TextFormatCode, []byte("42"), &n); err != nil || n != 42 {
+		t.Errorf("Want 42, got %d (%v)", n, err)
+	}
+}
+
+func TestDefaultPgType(t *testing.T) {
+	t.Parallel()
+
+	m := newMap()
+	for _, v := range []interface{}{airac.AIRAC(0), []airac.AIRAC(nil)} {
+		if _, ok := m.TypeForValue(v); !ok {
+			t.Errorf("%T: no default PostgreSQL type", v)
+		}
+	}
+	buf, err := m.Encode(0, pgtype.TextFormatCode, airac.FromStringMust("2313"), nil)
+	if err != nil || string(buf) != "2313" {
+		t.Errorf("Want 2313, got %q (%v)", buf, err)
+	}
+}
@@ -0,0 +1,45 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"math/rand"
+	"reflect"
+)
+
+// IdentifierRange is the range of AIRAC cycles whose two-digit identifiers are
+// unambiguous, i.e. "6401" (1964) to "6313" (2063).
+//
+// nolint:gochecknoglobals
+var IdentifierRange = Range{First: FromStringMust("6401"), Last: FromStringMust("6313")}
+
+// Generate implements the testing/quick.Generator interface. It returns a
+// random AIRAC cycle within IdentifierRange, so that generated values round
+// trip through String and FromString. The size hint is ignored.
+func (AIRAC) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(IdentifierRange.Random(r))
+}
+
+// Random returns a random AIRAC cycle within this range using r as the source
+// of randomness. It panics if the range is empty.
+func (rg Range) Random(r *rand.Rand) AIRAC {
+	n := rg.Len()
+	if n == 0 {
+		panic("airac: random cycle of empty range " + rg.First.String() + "-" + rg.Last.String())
+	}
+	return rg.First + AIRAC(r.Intn(n))
+}
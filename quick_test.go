@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"math/rand"
+	"testing"
+	"testing/quick"
+)
+
+// static assert
+var _ quick.Generator = AIRAC(0)
+
+func TestQuickRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	roundTrip := func(a AIRAC) bool {
+		got, err := FromString(a.String())
+		return err == nil && got == a
+	}
+	if err := quick.Check(roundTrip, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRangeRandom(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(1))
+	rg := Range{First: FromStringMust("2301"), Last: FromStringMust("2303")}
+	seen := make(map[AIRAC]bool)
+	for i := 0; i < 100; i++ {
+		a := rg.Random(r)
+		if !rg.Contains(a) {
+			t.Fatalf("Random cycle %s not within %s", a, rg)
+		}
+		seen[a] = true
+	}
+	if len(seen) != rg.Len() {
+		t.Errorf("Want all %d cycles of %s, got %d", rg.Len(), rg, len(seen))
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Random cycle of an empty range should have paniced")
+		}
+	}()
+	Range{First: rg.Last, Last: rg.First}.Random(r)
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package release
+
+import (
+	"time"
+
+	"github.com/jwkohnen/airac"
+)
+
+// LeadTime is the typical time before the effective date of a cycle at which
+// a provider publishes its data, e.g. 7 days.
+type LeadTime struct {
+	Provider Provider
+	Lead     time.Duration
+}
+
+// Planner plans the ingestion of cycle data from the lead times of providers.
+type Planner struct {
+	// LeadTimes are the lead times of the providers.
+	LeadTimes []LeadTime
+
+	// Download is the delay between the expected availability of the data
+	// and its download.
+	Download time.Duration
+
+	// Validation is the delay between the download and the validation of the
+	// data.
+	Validation time.Duration
+
+	// Activation is the offset of the activation of the data from the
+	// effective date of the cycle.
+	Activation time.Duration
+}
+
+// Entry is a planned ingestion of the data of an AIRAC cycle from a provider.
+type Entry struct {
+	AIRAC     airac.AIRAC
+	Provider  Provider
+	Available time.Time
+	Download  time.Time
+	Validate  time.Time
+	Activate  time.Time
+}
+
+// Late reports whether the data is planned to be validated after its
+// activation.
+func (e Entry) Late() bool {
+	return e.Validate.After(e.Activate)
+}
+
+// Plan returns the timetable of cycle a with one entry per provider in order
+// of LeadTimes.
+func (p Planner) Plan(a airac.AIRAC) []Entry {
+	effective := a.Effective()
+
+	entries := make([]Entry, 0, len(p.LeadTimes))
+	for _, lt := range p.LeadTimes {
+		available := effective.Add(-lt.Lead)
+		download := available.Add(p.Download)
+		entries = append(entries, Entry{
+			AIRAC:     a,
+			Provider:  lt.Provider,
+			Available: available,
+			Download:  download,
+			Validate:  download.Add(p.Validation),
+			Activate:  effective.Add(p.Activation),
+		})
+	}
+	return entries
+}
+
+// PlanRange returns the timetables of all cycles of r in chronological order.
+func (p Planner) PlanRange(r airac.Range) []Entry {
+	var entries []Entry
+	for a := r.First; r.Contains(a); a++ {
+		entries = append(entries, p.Plan(a)...)
+		if a == r.Last {
+			break
+		}
+	}
+	return entries
+}
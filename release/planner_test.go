@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package release
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jwkohnen/airac"
+)
+
+func TestPlanner(t *testing.T) {
+	t.Parallel()
+
+	const day = 24 * time.Hour
+
+	p := Planner{
+		LeadTimes: []LeadTime{
+			{Provider: "vendor-a", Lead: 7 * day},
+			{Provider: "vendor-b", Lead: 12 * time.Hour},
+		},
+		Download:   2 * time.Hour,
+		Validation: 22 * time.Hour,
+		Activation: time.Minute,
+	}
+
+	a := airac.FromStringMust("2401")
+	entries := p.Plan(a)
+	if len(entries) != 2 {
+		t.Fatalf("Want 2 entries, got %d", len(entries))
+	}
+
+	want := time.Date(2024, time.January, 18, 0, 0, 0, 0, time.UTC)
+	e := entries[0]
+	if e.Provider != "vendor-a" || !e.Available.Equal(want) ||
+		!e.Download.Equal(want.Add(2*time.Hour)) || !e.Validate.Equal(want.Add(day)) ||
+		!e.Activate.Equal(a.Effective().Add(time.Minute)) {
+		t.Errorf("Unexpected entry %+v", e)
+	}
+	if e.Late() {
+		t.Errorf("Entry %+v should not be late", e)
+	}
+	if !entries[1].Late() {
+		t.Errorf("Entry %+v should be late", entries[1])
+	}
+
+	r := airac.Range{First: a, Last: a + 2}
+	if got := len(p.PlanRange(r)); got != 6 {
+		t.Errorf("Want 6 entries, got %d", got)
+	}
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package release coordinates the releases of AIRAC cycle data by multiple
+// upstream providers, e.g. navdata vendors.
+package release
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jwkohnen/airac"
+)
+
+// Provider identifies an upstream provider of cycle data.
+type Provider string
+
+// Release is the publication of the data of an AIRAC cycle by a provider.
+type Release struct {
+	Provider  Provider
+	AIRAC     airac.AIRAC
+	Published time.Time
+}
+
+// Tracker records which providers have published the data of which AIRAC
+// cycle. It is safe for concurrent use.
+type Tracker struct {
+	mu        sync.Mutex
+	providers []Provider
+	releases  map[airac.AIRAC]map[Provider]time.Time
+}
+
+// NewTracker returns a Tracker that expects releases of the given providers.
+func NewTracker(providers ...Provider) *Tracker {
+	return &Tracker{
+		providers: append([]Provider(nil), providers...),
+		releases:  make(map[airac.AIRAC]map[Provider]time.Time),
+	}
+}
+
+// Providers returns the expected providers in order of NewTracker.
+func (t *Tracker) Providers() []Provider {
+	return append([]Provider(nil), t.providers...)
+}
+
+// Record records that provider p published the data of cycle a at published.
+// If the release has already been recorded, the earlier publication time is
+// kept.
+func (t *Tracker) Record(p Provider, a airac.AIRAC, published time.Time) error {
+	if !t.expects(p) {
+		return fmt.Errorf("unknown provider %q", p)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	releases, ok := t.releases[a]
+	if !ok {
+		releases = make(map[Provider]time.Time)
+		t.releases[a] = releases
+	}
+	if prev, ok := releases[p]; !ok || published.Before(prev) {
+		releases[p] = published
+	}
+	return nil
+}
+
+// Releases returns the recorded releases of cycle a in order of publication.
+func (t *Tracker) Releases(a airac.AIRAC) []Release {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	releases := make([]Release, 0, len(t.releases[a]))
+	for p, published := range t.releases[a] {
+		releases = append(releases, Release{Provider: p, AIRAC: a, Published: published})
+	}
+	sort.Slice(releases, func(i, j int) bool {
+		if !releases[i].Published.Equal(releases[j].Published) {
+			return releases[i].Published.Before(releases[j].Published)
+		}
+		return releases[i].Provider < releases[j].Provider
+	})
+	return releases
+}
+
+// Missing returns the providers that have not published the data of cycle a
+// yet, in order of NewTracker.
+func (t *Tracker) Missing(a airac.AIRAC) []Provider {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var missing []Provider
+	for _, p := range t.providers {
+		if _, ok := t.releases[a][p]; !ok {
+			missing = append(missing, p)
+		}
+	}
+	return missing
+}
+
+// Complete reports whether all providers have published the data of cycle a.
+func (t *Tracker) Complete(a airac.AIRAC) bool {
+	return len(t.Missing(a)) == 0
+}
+
+// MissingUpcoming returns the cycle following the one effective at now and the
+// providers that have not published its data yet.
+func (t *Tracker) MissingUpcoming(now time.Time) (airac.AIRAC, []Provider) {
+	upcoming := airac.FromDate(now) + 1
+	return upcoming, t.Missing(upcoming)
+}
+
+func (t *Tracker) expects(p Provider) bool {
+	for _, e := range t.providers {
+		if e == p {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package release
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jwkohnen/airac"
+)
+
+func TestTracker(t *testing.T) {
+	t.Parallel()
+
+	tr := NewTracker("vendor-a", "vendor-b", "vendor-c")
+	a := airac.FromStringMust("2401")
+	eff := a.Effective()
+
+	if err := tr.Record("vendor-b", a, eff.Add(-5*24*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if err := tr.Record("vendor-a", a, eff.Add(-7*24*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if err := tr.Record("vendor-a", a, eff.Add(-6*24*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if err := tr.Record("vendor-x", a, eff); err == nil {
+		t.Error("Recording an unknown provider should have raised an error")
+	}
+
+	upcoming, missing := tr.MissingUpcoming(eff.Add(-24 * time.Hour))
+	if upcoming != a {
+		t.Errorf("Want upcoming cycle %s, got %s", a, upcoming)
+	}
+	if got, want := fmt.Sprint(missing), "[vendor-c]"; got != want {
+		t.Errorf("Want missing %s, got %s", want, got)
+	}
+	if tr.Complete(a) {
+		t.Errorf("Cycle %s should not be complete", a)
+	}
+
+	releases := tr.Releases(a)
+	if len(releases) != 2 || releases[0].Provider != "vendor-a" ||
+		!releases[0].Published.Equal(eff.Add(-7*24*time.Hour)) || releases[1].Provider != "vendor-b" {
+		t.Errorf("Unexpected releases %v", releases)
+	}
+
+	if err := tr.Record("vendor-c", a, eff); err != nil {
+		t.Fatal(err)
+	}
+	if !tr.Complete(a) {
+		t.Errorf("Cycle %s should be complete", a)
+	}
+	if got := tr.Missing(a + 1); len(got) != 3 {
+		t.Errorf("Want all providers missing for %s, got %v", a+1, got)
+	}
+}
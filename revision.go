@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// nolint:gochecknoglobals
+var _revision = regexp.MustCompile(`(?i)^(\d{4})\s*(?:(?:rev\.?|r)\s*(\d+))?$`)
+
+// CycleRevision represents an in-cycle revision of an AIRAC cycle's data as
+// shipped by navdata providers. Revision 0 denotes the initial release of the
+// cycle.
+type CycleRevision struct {
+	AIRAC    AIRAC
+	Revision uint
+}
+
+// CycleRevisionFromString returns the cycle revision that matches the
+// identifier <yyoo> with an optional revision suffix, e.g. "2313", "2313 rev 2",
+// "2313 rev. 2" or "2313r2".
+func CycleRevisionFromString(s string) (CycleRevision, error) {
+	m := _revision.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return CycleRevision{}, fmt.Errorf("illegal AIRAC cycle revision %q", s)
+	}
+
+	airac, err := FromString(m[1])
+	if err != nil {
+		return CycleRevision{}, err
+	}
+
+	var revision uint64
+	if m[2] != "" {
+		revision, err = strconv.ParseUint(m[2], 10, 0)
+		if err != nil {
+			return CycleRevision{}, fmt.Errorf("illegal AIRAC cycle revision %q", s)
+		}
+	}
+
+	return CycleRevision{AIRAC: airac, Revision: uint(revision)}, nil
+}
+
+// String returns a short representation of this cycle revision. "YYOO rev N"
+// or "YYOO" for the initial release.
+func (r CycleRevision) String() string {
+	if r.Revision == 0 {
+		return r.AIRAC.String()
+	}
+	return fmt.Sprintf("%s rev %d", r.AIRAC, r.Revision)
+}
+
+// Before reports whether this cycle revision precedes o.
+func (r CycleRevision) Before(o CycleRevision) bool {
+	if r.AIRAC != o.AIRAC {
+		return r.AIRAC < o.AIRAC
+	}
+	return r.Revision < o.Revision
+}
+
+// ByRevision is an []CycleRevision wrapper, that satisfies sort.Interface and
+// can be used to chronologically sort CycleRevision instances.
+type ByRevision []CycleRevision
+
+// Len ist the number of elements in the collection.
+func (c ByRevision) Len() int { return len(c) }
+
+// Less reports whether the element with index i should sort before the element
+// with index j.
+func (c ByRevision) Less(i, j int) bool { return c[i].Before(c[j]) }
+
+// Swap swaps the elements with indexes i and j.
+func (c ByRevision) Swap(i, j int) { c[i], c[j] = c[j], c[i] }
+
+// static assert
+var _ sort.Interface = (ByRevision)(nil)
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+)
+
+func TestCycleRevisionFromString(t *testing.T) {
+	t.Parallel()
+
+	testt := []struct {
+		s        string
+		airac    string
+		revision uint
+		valid    bool
+	}{
+		{"2313", "2313", 0, true},
+		{"2313 rev 2", "2313", 2, true},
+		{"2313 REV. 2", "2313", 2, true},
+		{"2313r2", "2313", 2, true},
+		{" 2313 rev 0 ", "2313", 0, true},
+		{"2314 rev 1", "", 0, false},
+		{"2313 rev", "", 0, false},
+		{"2313 rev -1", "", 0, false},
+		{"2313 v2", "", 0, false},
+		{"rev 2", "", 0, false},
+	}
+
+	for _, tt := range testt {
+		got, err := CycleRevisionFromString(tt.s)
+		if tt.valid != (err == nil) {
+			t.Errorf("%q: want valid %t, got error %v", tt.s, tt.valid, err)
+			continue
+		}
+		if tt.valid && (got.AIRAC.String() != tt.airac || got.Revision != tt.revision) {
+			t.Errorf("%q: want %s rev %d, got %s", tt.s, tt.airac, tt.revision, got)
+		}
+	}
+}
+
+func TestCycleRevisionString(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{"2313", "2313 rev 1", "2401 rev 12"} {
+		r, err := CycleRevisionFromString(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.String() != s {
+			t.Errorf("Round trip of %q yields %q", s, r.String())
+		}
+	}
+}
+
+func ExampleByRevision() {
+	var revisions []CycleRevision
+	for _, s := range []string{"2401", "2313 rev 2", "2313", "2313 rev 1"} {
+		r, err := CycleRevisionFromString(s)
+		if err != nil {
+			panic(err)
+		}
+		revisions = append(revisions, r)
+	}
+
+	sort.Sort(ByRevision(revisions))
+	fmt.Println(revisions)
+
+	// Output:
+	// [2313 2313 rev 1 2313 rev 2 2401]
+}
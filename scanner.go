@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"bufio"
+	"io"
+)
+
+// ScanMatch is an AIRAC identifier found by a Scanner. The embedded Match
+// holds the byte offsets within the line, Line is the 1-based line number and
+// Offset is the byte offset of the identifier within the whole input.
+type ScanMatch struct {
+	Match
+	Line   int
+	Offset int64
+}
+
+// Scanner streams an input line by line and yields the AIRAC identifiers found
+// by ExtractIdentifiers, without loading the whole input into memory. Like
+// bufio.Scanner, lines longer than the buffer stop the scan with an error; use
+// Buffer to allow longer lines. Identifiers are not detected if they span
+// lines.
+type Scanner struct {
+	lines   *bufio.Scanner
+	line    int
+	next    int64
+	advance int
+	pending []ScanMatch
+	match   ScanMatch
+}
+
+// NewScanner returns a new Scanner to read from r.
+func NewScanner(r io.Reader) *Scanner {
+	s := &Scanner{lines: bufio.NewScanner(r)}
+	s.lines.Split(s.split)
+	return s
+}
+
+func (s *Scanner) split(data []byte, atEOF bool) (int, []byte, error) {
+	advance, token, err := bufio.ScanLines(data, atEOF)
+	if token != nil {
+		s.advance = advance
+	}
+	return advance, token, err
+}
+
+// Buffer sets the initial buffer and the maximum line length of the scanner
+// like bufio.Scanner.Buffer. It panics if called after scanning has started.
+func (s *Scanner) Buffer(buf []byte, max int) {
+	s.lines.Buffer(buf, max)
+}
+
+// Scan advances the scanner to the next identifier, which will then be
+// available through the Match method. It returns false when the scan stops,
+// either by reaching the end of the input or an error.
+func (s *Scanner) Scan() bool {
+	for len(s.pending) == 0 {
+		if !s.lines.Scan() {
+			return false
+		}
+
+		s.line++
+		start := s.next
+		s.next += int64(s.advance)
+
+		for _, m := range ExtractIdentifiers(s.lines.Text()) {
+			s.pending = append(s.pending, ScanMatch{
+				Match:  m,
+				Line:   s.line,
+				Offset: start + int64(m.Start),
+			})
+		}
+	}
+
+	s.match, s.pending = s.pending[0], s.pending[1:]
+	return true
+}
+
+// Match returns the most recent identifier found by a call to Scan.
+func (s *Scanner) Match() ScanMatch {
+	return s.match
+}
+
+// Err returns the first non-EOF error that was encountered by the Scanner.
+func (s *Scanner) Err() error {
+	return s.lines.Err()
+}
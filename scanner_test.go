@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestScanner(t *testing.T) {
+	t.Parallel()
+
+	input := "header\r\nAIRAC 2313 and 2401\n\nnothing 2023\nlast cycle 2402"
+	want := []struct {
+		airac  string
+		line   int
+		start  int
+		offset int64
+	}{
+		{"2313", 2, 6, 14},
+		{"2401", 2, 15, 23},
+		{"2402", 5, 11, 53},
+	}
+
+	s := NewScanner(strings.NewReader(input))
+	var i int
+	for ; s.Scan(); i++ {
+		if i >= len(want) {
+			t.Fatalf("Unexpected match %+v", s.Match())
+		}
+		m, w := s.Match(), want[i]
+		if m.AIRAC.String() != w.airac || m.Line != w.line || m.Start != w.start || m.Offset != w.offset {
+			t.Errorf("Want %+v, got %+v", w, m)
+		}
+		if got := input[m.Offset : m.Offset+4]; got != w.airac {
+			t.Errorf("Offset %d yields %q, want %s", m.Offset, got, w.airac)
+		}
+	}
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if i != len(want) {
+		t.Errorf("Want %d matches, got %d", len(want), i)
+	}
+}
+
+func TestScannerLongLine(t *testing.T) {
+	t.Parallel()
+
+	s := NewScanner(strings.NewReader(strings.Repeat("x", 100) + " AIRAC 2313"))
+	s.Buffer(make([]byte, 16), 64)
+	if s.Scan() {
+		t.Errorf("Unexpected match %+v", s.Match())
+	}
+	if s.Err() == nil {
+		t.Error("Line longer than buffer should have raised an error")
+	}
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schedule
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// Command is a Notifier that runs a local command for each event. The command
+// receives the Payload of the event on standard input and in the environment
+// variables AIRAC_PREVIOUS, AIRAC_CURRENT and AIRAC_EFFECTIVE (RFC 3339), in
+// addition to the environment of the current process.
+type Command struct {
+	name string
+	args []string
+}
+
+// NewCommand returns a Notifier that runs the program name with args.
+func NewCommand(name string, args ...string) *Command {
+	return &Command{name: name, args: append([]string(nil), args...)}
+}
+
+// Notify implements Notifier. A non-zero exit status is an error that
+// includes the standard error output of the command.
+func (c *Command) Notify(ctx context.Context, e Event) error {
+	payload, err := json.Marshal(NewPayload(e))
+	if err != nil {
+		return err
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, c.name, c.args...)
+	cmd.Stdin = bytes.NewReader(payload)
+	cmd.Stderr = &stderr
+	cmd.Env = append(os.Environ(),
+		"AIRAC_PREVIOUS="+e.Previous.String(),
+		"AIRAC_CURRENT="+e.Current.String(),
+		"AIRAC_EFFECTIVE="+e.Effective.Format(time.RFC3339),
+	)
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("command %s for cycle %s: %w: %s", c.name, e.Current, err, msg)
+		}
+		return fmt.Errorf("command %s for cycle %s: %w", c.name, e.Current, err)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schedule
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCommand(t *testing.T) {
+	t.Parallel()
+
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("no shell available")
+	}
+
+	out := filepath.Join(t.TempDir(), "out")
+	c := NewCommand(sh, "-c", `printf '%s %s %s ' "$AIRAC_PREVIOUS" "$AIRAC_CURRENT" "$AIRAC_EFFECTIVE" > "$0"; cat >> "$0"`, out)
+	if err := c.Notify(context.Background(), testEvent()); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `2312 2313 2023-12-28T00:00:00Z {"previous":"2312","current":"2313","effective":"2023-12-28T00:00:00Z"}`; string(data) != want {
+		t.Errorf("Want %q, got %q", want, data)
+	}
+
+	err = NewCommand(sh, "-c", "echo broken >&2; exit 3").Notify(context.Background(), testEvent())
+	if err == nil || !strings.Contains(err.Error(), "broken") {
+		t.Errorf("Want an error with the output of the command, got %v", err)
+	}
+}
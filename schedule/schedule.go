@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package schedule notifies interested parties when a new AIRAC cycle becomes
+// effective. A Scheduler follows the real clock, a Simulator replays cycle
+// changes on a virtual clock at a configurable speed, e.g. to load test data
+// pipelines without waiting 28 days per transition. Webhook and Command are
+// ready-made Notifiers for push notifications on rollover.
+package schedule
+
+import (
+	"context"
+	"time"
+
+	"github.com/jwkohnen/airac"
+)
+
+// Event is the change from the Previous to the Current AIRAC cycle at the
+// effective date of the current cycle.
+type Event struct {
+	Previous  airac.AIRAC
+	Current   airac.AIRAC
+	Effective time.Time
+}
+
+// Notifier is notified of cycle changes.
+type Notifier interface {
+	Notify(ctx context.Context, e Event) error
+}
+
+// NotifierFunc is a function that implements Notifier, e.g. to refresh an
+// airac.CurrentCache:
+//
+//	schedule.NotifierFunc(func(ctx context.Context, e schedule.Event) error {
+//		cache.Refresh(e.Effective)
+//		return nil
+//	})
+type NotifierFunc func(ctx context.Context, e Event) error
+
+// Notify implements Notifier.
+func (f NotifierFunc) Notify(ctx context.Context, e Event) error {
+	return f(ctx, e)
+}
+
+// Clock is the time source of a Scheduler.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// Sleep pauses until d has elapsed or ctx is done, in which case it
+	// returns the error of ctx.
+	Sleep(ctx context.Context, d time.Duration) error
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Scheduler notifies its Notifiers in order whenever a new AIRAC cycle
+// becomes effective. If the clock jumps over several boundaries, e.g. after
+// a suspend, there is one event per cycle.
+type Scheduler struct {
+	// Notifiers are notified of every cycle change.
+	Notifiers []Notifier
+
+	// Clock is the time source. If nil, the system clock is used.
+	Clock Clock
+
+	// Calendar defines the cycles and their effective dates, e.g.
+	// airac.ICAO().WithOffsetMust(time.Minute) for an activation at 00:01 UTC.
+	// If zero, airac.ICAO() is used.
+	Calendar airac.Calendar
+
+	// OnError is called with the errors of Notifiers. If nil, errors are
+	// ignored. Errors do not stop the scheduler.
+	OnError func(e Event, err error)
+}
+
+// Run notifies the Notifiers of cycle changes until ctx is done and returns
+// the error of ctx.
+func (s *Scheduler) Run(ctx context.Context) error {
+	return s.run(ctx, 0)
+}
+
+// run is Run, but stops after limit events if limit is positive.
+func (s *Scheduler) run(ctx context.Context, limit int) error {
+	clock := s.Clock
+	if clock == nil {
+		clock = systemClock{}
+	}
+
+	cal := calendar(s.Calendar)
+
+	current := cal.FromDate(clock.Now())
+	for events := 0; ; {
+		if err := clock.Sleep(ctx, cal.Effective(current+1).Sub(clock.Now())); err != nil {
+			return err
+		}
+
+		reached := cal.FromDate(clock.Now())
+		for ; current < reached; current++ {
+			e := Event{Previous: current, Current: current + 1, Effective: cal.Effective(current + 1)}
+			s.notify(ctx, e)
+			if events++; limit > 0 && events >= limit {
+				return nil
+			}
+		}
+	}
+}
+
+// calendar returns cal or airac.ICAO() if cal is the zero value.
+func calendar(cal airac.Calendar) airac.Calendar {
+	if cal.Period() == 0 {
+		return airac.ICAO()
+	}
+	return cal
+}
+
+func (s *Scheduler) notify(ctx context.Context, e Event) {
+	for _, n := range s.Notifiers {
+		if err := n.Notify(ctx, e); err != nil && s.OnError != nil {
+			s.OnError(e, err)
+		}
+	}
+}
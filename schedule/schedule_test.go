@@ -0,0 +1,157 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schedule
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jwkohnen/airac"
+)
+
+// recorder records the events it is notified of.
+type recorder struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+func (r *recorder) Notify(ctx context.Context, e Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, e)
+	return nil
+}
+
+func (r *recorder) currents() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var ids []string
+	for _, e := range r.events {
+		ids = append(ids, e.Current.String())
+	}
+	return ids
+}
+
+// jumpClock jumps by a fixed duration on each sleep and stops after a number
+// of sleeps.
+type jumpClock struct {
+	now    time.Time
+	jump   time.Duration
+	sleeps int
+}
+
+func (c *jumpClock) Now() time.Time {
+	return c.now
+}
+
+func (c *jumpClock) Sleep(ctx context.Context, d time.Duration) error {
+	if c.sleeps == 0 {
+		return context.Canceled
+	}
+	c.sleeps--
+	c.now = c.now.Add(c.jump)
+	return nil
+}
+
+func TestScheduler(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	rec := &recorder{}
+	var failed []string
+	s := &Scheduler{
+		Notifiers: []Notifier{
+			NotifierFunc(func(ctx context.Context, e Event) error { return errors.New("boom") }),
+			rec,
+		},
+		Clock:   &jumpClock{now: start, jump: 60 * 24 * time.Hour, sleeps: 2},
+		OnError: func(e Event, err error) { failed = append(failed, e.Current.String()) },
+	}
+
+	if err := s.Run(context.Background()); !errors.Is(err, context.Canceled) {
+		t.Errorf("Want context.Canceled, got %v", err)
+	}
+
+	// 2024-03-01 reaches 2402, 2024-04-30 reaches 2404.
+	if got, want := rec.currents(), []string{"2401", "2402", "2403", "2404"}; !equal(got, want) {
+		t.Errorf("Want events %v, got %v", want, got)
+	}
+	if !equal(failed, rec.currents()) {
+		t.Errorf("Want errors of %v, got %v", rec.currents(), failed)
+	}
+	if e := rec.events[0]; e.Previous.String() != "2313" || !e.Effective.Equal(airac.FromStringMust("2401").Effective()) {
+		t.Errorf("Unexpected first event %+v", e)
+	}
+}
+
+func TestSchedulerCalendar(t *testing.T) {
+	t.Parallel()
+
+	cal, err := airac.ICAO().WithOffset(time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 2401 becomes effective at 2024-01-25 00:01 UTC.
+	start := time.Date(2024, time.January, 25, 0, 0, 30, 0, time.UTC)
+	rec := &recorder{}
+	s := &Scheduler{
+		Notifiers: []Notifier{rec},
+		Clock:     &jumpClock{now: start, jump: time.Minute, sleeps: 1},
+		Calendar:  cal,
+	}
+	if err := s.Run(context.Background()); !errors.Is(err, context.Canceled) {
+		t.Errorf("Want context.Canceled, got %v", err)
+	}
+
+	if got, want := rec.currents(), []string{"2401"}; !equal(got, want) {
+		t.Fatalf("Want events %v, got %v", want, got)
+	}
+	if want := start.Add(30 * time.Second); !rec.events[0].Effective.Equal(want) {
+		t.Errorf("Want effective %s, got %s", want, rec.events[0].Effective)
+	}
+}
+
+func TestSchedulerSystemClock(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	rec := &recorder{}
+	if err := (&Scheduler{Notifiers: []Notifier{rec}}).Run(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Want context.DeadlineExceeded, got %v", err)
+	}
+	if len(rec.currents()) != 0 {
+		t.Errorf("Want no events, got %v", rec.currents())
+	}
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
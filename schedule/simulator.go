@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jwkohnen/airac"
+)
+
+// Simulator replays cycle changes on a virtual clock through the same
+// Notifiers as a Scheduler.
+type Simulator struct {
+	// Start is the virtual time the simulation starts at.
+	Start time.Time
+
+	// PerCycle is the real time one cycle takes, e.g. time.Second. If zero,
+	// the cycle changes are replayed without delay.
+	PerCycle time.Duration
+
+	// Cycles is the number of cycle changes to replay. If zero, the
+	// simulation runs until its context is done.
+	Cycles int
+
+	// Calendar defines the cycles like for a Scheduler. If zero, airac.ICAO()
+	// is used.
+	Calendar airac.Calendar
+
+	// OnError is called with the errors of Notifiers like for a Scheduler.
+	OnError func(e Event, err error)
+}
+
+// Run replays cycle changes to notifiers. It returns nil after Cycles changes
+// or the error of ctx if it is done before.
+func (s Simulator) Run(ctx context.Context, notifiers ...Notifier) error {
+	if s.PerCycle < 0 || s.Cycles < 0 {
+		return fmt.Errorf("illegal simulation of %d cycles at %s per cycle", s.Cycles, s.PerCycle)
+	}
+	scheduler := &Scheduler{
+		Notifiers: notifiers,
+		Clock:     NewCalendarVirtualClock(s.Calendar, s.Start, s.PerCycle),
+		Calendar:  s.Calendar,
+		OnError:   s.OnError,
+	}
+	return scheduler.run(ctx, s.Cycles)
+}
+
+// VirtualClock is a Clock whose time only advances by sleeping, at a speed of
+// one cycle per PerCycle of real time. It is safe for concurrent use.
+type VirtualClock struct {
+	mu       sync.Mutex
+	now      time.Time
+	period   time.Duration
+	perCycle time.Duration
+}
+
+// NewVirtualClock returns a clock that starts at start and sleeps perCycle of
+// real time per AIRAC cycle, or not at all if perCycle is zero.
+func NewVirtualClock(start time.Time, perCycle time.Duration) *VirtualClock {
+	return NewCalendarVirtualClock(airac.ICAO(), start, perCycle)
+}
+
+// NewCalendarVirtualClock is like NewVirtualClock, but sleeps perCycle of real
+// time per cycle of cal. If cal is zero, airac.ICAO() is used.
+func NewCalendarVirtualClock(cal airac.Calendar, start time.Time, perCycle time.Duration) *VirtualClock {
+	return &VirtualClock{now: start, period: calendar(cal).Period(), perCycle: perCycle}
+}
+
+// Now implements Clock.
+func (c *VirtualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Sleep implements Clock. It sleeps d scaled to real time and advances the
+// virtual time by d.
+func (c *VirtualClock) Sleep(ctx context.Context, d time.Duration) error {
+	if d < 0 {
+		d = 0
+	}
+	if c.perCycle > 0 {
+		wall := time.Duration(float64(d) / float64(c.period) * float64(c.perCycle))
+		if err := (systemClock{}).Sleep(ctx, wall); err != nil {
+			return err
+		}
+	} else if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	return nil
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schedule
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jwkohnen/airac"
+)
+
+func TestSimulator(t *testing.T) {
+	t.Parallel()
+
+	rec := &recorder{}
+	sim := Simulator{Start: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), Cycles: 3}
+	if err := sim.Run(context.Background(), rec); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := rec.currents(), []string{"2401", "2402", "2403"}; !equal(got, want) {
+		t.Errorf("Want events %v, got %v", want, got)
+	}
+}
+
+func TestSimulatorCalendar(t *testing.T) {
+	t.Parallel()
+
+	cal, err := airac.NewCalendar(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), 7*24*time.Hour, 2000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rec := &recorder{}
+	sim := Simulator{
+		Start:    time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		PerCycle: 10 * time.Millisecond,
+		Cycles:   2,
+		Calendar: cal,
+	}
+
+	begin := time.Now()
+	if err := sim.Run(context.Background(), rec); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(begin); elapsed < 20*time.Millisecond || elapsed > time.Second {
+		t.Errorf("Two weekly cycles should take about 20ms, took %s", elapsed)
+	}
+	if len(rec.events) != 2 || !rec.events[1].Effective.Equal(time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Want weekly events, got %+v", rec.events)
+	}
+}
+
+func TestSimulatorSpeed(t *testing.T) {
+	t.Parallel()
+
+	rec := &recorder{}
+	sim := Simulator{Start: time.Date(2024, time.January, 25, 0, 0, 0, 0, time.UTC), PerCycle: 20 * time.Millisecond, Cycles: 2}
+
+	begin := time.Now()
+	if err := sim.Run(context.Background(), rec); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(begin); elapsed < 40*time.Millisecond {
+		t.Errorf("Two cycles should take at least 40ms, took %s", elapsed)
+	}
+	if got, want := rec.currents(), []string{"2402", "2403"}; !equal(got, want) {
+		t.Errorf("Want events %v, got %v", want, got)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	sim.Cycles = 0
+	if err := sim.Run(ctx, rec); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Want context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestSimulatorIllegal(t *testing.T) {
+	t.Parallel()
+
+	for _, sim := range []Simulator{{PerCycle: -1}, {Cycles: -1}} {
+		if err := sim.Run(context.Background()); err == nil {
+			t.Errorf("%+v should have raised an error", sim)
+		}
+	}
+}
+
+func TestVirtualClock(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	c := NewVirtualClock(start, 0)
+	if err := c.Sleep(context.Background(), time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Sleep(context.Background(), -time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := c.Now(), start.Add(time.Hour); !got.Equal(want) {
+		t.Errorf("Want %s, got %s", want, got)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := c.Sleep(ctx, time.Hour); !errors.Is(err, context.Canceled) {
+		t.Errorf("Want context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schedule
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Payload is the JSON representation of an Event that Webhook and Command
+// send, e.g.
+//
+//	{"previous":"2312","current":"2313","effective":"2023-12-28T00:00:00Z"}
+type Payload struct {
+	Previous  string    `json:"previous"`
+	Current   string    `json:"current"`
+	Effective time.Time `json:"effective"`
+}
+
+// NewPayload returns the payload of event e.
+func NewPayload(e Event) Payload {
+	return Payload{Previous: e.Previous.String(), Current: e.Current.String(), Effective: e.Effective}
+}
+
+// Webhook is a Notifier that posts the Payload of each event to a URL.
+type Webhook struct {
+	url    string
+	client *http.Client
+
+	// Header is added to each request, e.g. for authorization.
+	Header http.Header
+}
+
+// NewWebhook returns a Notifier that posts to url with client. If client is
+// nil, http.DefaultClient is used.
+func NewWebhook(url string, client *http.Client) *Webhook {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Webhook{url: url, client: client, Header: make(http.Header)}
+}
+
+// Notify implements Notifier. Responses other than 2xx are errors.
+func (w *Webhook) Notify(ctx context.Context, e Event) error {
+	body, err := json.Marshal(NewPayload(e))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range w.Header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook %s for cycle %s: %s", w.url, e.Current, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jwkohnen/airac"
+)
+
+func testEvent() Event {
+	a := airac.FromStringMust("2313")
+	return Event{Previous: a - 1, Current: a, Effective: a.Effective()}
+}
+
+func TestWebhook(t *testing.T) {
+	t.Parallel()
+
+	var (
+		got    Payload
+		header http.Header
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/fail" {
+			http.Error(w, "nope", http.StatusInternalServerError)
+			return
+		}
+		header = r.Header
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	w := NewWebhook(srv.URL, srv.Client())
+	w.Header.Set("Authorization", "Bearer secret")
+	if err := w.Notify(context.Background(), testEvent()); err != nil {
+		t.Fatal(err)
+	}
+	if want := NewPayload(testEvent()); got.Previous != "2312" || got.Current != "2313" || !got.Effective.Equal(want.Effective) {
+		t.Errorf("Want payload %+v, got %+v", want, got)
+	}
+	if header.Get("Authorization") != "Bearer secret" || header.Get("Content-Type") != "application/json" {
+		t.Errorf("Unexpected request header %v", header)
+	}
+
+	if err := NewWebhook(srv.URL+"/fail", nil).Notify(context.Background(), testEvent()); err == nil {
+		t.Error("A failed delivery should have raised an error")
+	}
+}
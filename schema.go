@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+// JSONSchema is a JSON Schema (draft 2020-12) of the serialized form of an
+// AIRACIdentifier value, i.e. the identifier "YYOO".
+const JSONSchema = `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$id": "https://github.com/jwkohnen/airac/schema/airac.json",
+  "title": "AIRAC cycle",
+  "description": "AIRAC cycle identifier: the last two digits of the year and the ordinal, each with leading zeros.",
+  "type": "string",
+  "pattern": "^[0-9]{2}(0[1-9]|1[0-4])$",
+  "examples": ["2313"]
+}`
+
+// SerialJSONSchema is a JSON Schema (draft 2020-12) of the serialized form of
+// an AIRAC value, i.e. its serial number.
+const SerialJSONSchema = `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$id": "https://github.com/jwkohnen/airac/schema/airac-serial.json",
+  "title": "AIRAC cycle serial number",
+  "description": "Number of AIRAC cycles since 1901-01-10.",
+  "type": "integer",
+  "minimum": 0,
+  "maximum": ` + maxSerialJSON + `,
+  "examples": [1604]
+}`
+
+// maxSerialJSON is MaxSerial as JSON number. It cannot be derived from
+// MaxSerial in a constant expression; TestSerialJSONSchema pins it.
+const maxSerialJSON = "3811"
+
+// DetailsJSONSchema is a JSON Schema (draft 2020-12) of the serialized form of
+// Details.
+const DetailsJSONSchema = `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$id": "https://github.com/jwkohnen/airac/schema/details.json",
+  "title": "AIRAC cycle details",
+  "type": "object",
+  "properties": {
+    "identifier": {"$ref": "https://github.com/jwkohnen/airac/schema/airac.json"},
+    "serial": {"type": "integer", "minimum": 0, "maximum": ` + maxSerialJSON + `},
+    "year": {"type": "integer"},
+    "ordinal": {"type": "integer", "minimum": 1, "maximum": 14},
+    "effective": {"type": "string", "format": "date"},
+    "expires": {"type": "string", "format": "date"},
+    "durationDays": {"type": "integer", "const": 28}
+  },
+  "required": ["identifier", "serial", "year", "ordinal", "effective", "expires", "durationDays"],
+  "additionalProperties": false
+}`
+
+// OpenAPISchemas is an OpenAPI 3.1 fragment to be embedded as
+// "components.schemas". It defines the schemas "AIRAC", "AIRACSerial" and
+// "AIRACDetails" that correspond to JSONSchema, SerialJSONSchema and
+// DetailsJSONSchema.
+const OpenAPISchemas = `{
+  "AIRAC": {
+    "description": "AIRAC cycle identifier: the last two digits of the year and the ordinal, each with leading zeros.",
+    "type": "string",
+    "pattern": "^[0-9]{2}(0[1-9]|1[0-4])$",
+    "example": "2313"
+  },
+  "AIRACSerial": {
+    "description": "Number of AIRAC cycles since 1901-01-10.",
+    "type": "integer",
+    "minimum": 0,
+    "maximum": ` + maxSerialJSON + `,
+    "example": 1604
+  },
+  "AIRACDetails": {
+    "type": "object",
+    "properties": {
+      "identifier": {"$ref": "#/components/schemas/AIRAC"},
+      "serial": {"type": "integer", "minimum": 0, "maximum": ` + maxSerialJSON + `},
+      "year": {"type": "integer"},
+      "ordinal": {"type": "integer", "minimum": 1, "maximum": 14},
+      "effective": {"type": "string", "format": "date"},
+      "expires": {"type": "string", "format": "date"},
+      "durationDays": {"type": "integer", "const": 28}
+    },
+    "required": ["identifier", "serial", "year", "ordinal", "effective", "expires", "durationDays"],
+    "additionalProperties": false
+  }
+}`
@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+)
+
+type testSchema struct {
+	Pattern    string                     `json:"pattern"`
+	Properties map[string]json.RawMessage `json:"properties"`
+	Required   []string                   `json:"required"`
+}
+
+func TestJSONSchema(t *testing.T) {
+	t.Parallel()
+
+	var schema testSchema
+	if err := json.Unmarshal([]byte(JSONSchema), &schema); err != nil {
+		t.Fatal(err)
+	}
+
+	re := regexp.MustCompile(schema.Pattern)
+	for a := FromStringMust("6401"); a <= FromStringMust("6313"); a++ {
+		b, err := AIRACIdentifier(a).MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !re.Match(b) {
+			t.Errorf("Identifier %s does not match schema pattern %s", b, schema.Pattern)
+		}
+	}
+}
+
+func TestSerialJSONSchema(t *testing.T) {
+	t.Parallel()
+
+	type bounds struct {
+		Minimum *int `json:"minimum"`
+		Maximum *int `json:"maximum"`
+	}
+	type properties struct {
+		Properties struct {
+			Serial bounds `json:"serial"`
+		} `json:"properties"`
+	}
+
+	var serial bounds
+	if err := json.Unmarshal([]byte(SerialJSONSchema), &serial); err != nil {
+		t.Fatal(err)
+	}
+	var details properties
+	if err := json.Unmarshal([]byte(DetailsJSONSchema), &details); err != nil {
+		t.Fatal(err)
+	}
+	var openapi struct {
+		AIRACSerial  *bounds    `json:"AIRACSerial"`
+		AIRACDetails properties `json:"AIRACDetails"`
+	}
+	if err := json.Unmarshal([]byte(OpenAPISchemas), &openapi); err != nil {
+		t.Fatal(err)
+	}
+	if openapi.AIRACSerial == nil {
+		t.Fatal("Missing OpenAPI schema AIRACSerial")
+	}
+
+	for name, schema := range map[string]bounds{
+		"serial":          serial,
+		"details":         details.Properties.Serial,
+		"openapi serial":  *openapi.AIRACSerial,
+		"openapi details": openapi.AIRACDetails.Properties.Serial,
+	} {
+		if schema.Minimum == nil || schema.Maximum == nil {
+			t.Errorf("%s: missing bounds", name)
+			continue
+		}
+		if *schema.Minimum != 0 || *schema.Maximum != int(MaxSerial) {
+			t.Errorf("%s: want serials 0-%d, got %d-%d", name, MaxSerial, *schema.Minimum, *schema.Maximum)
+		}
+	}
+}
+
+func TestDetailsJSONSchema(t *testing.T) {
+	t.Parallel()
+
+	var fields []string
+	typ := reflect.TypeOf(Details{})
+	for i := 0; i < typ.NumField(); i++ {
+		fields = append(fields, strings.Split(typ.Field(i).Tag.Get("json"), ",")[0])
+	}
+	sort.Strings(fields)
+
+	var openapi map[string]testSchema
+	if err := json.Unmarshal([]byte(OpenAPISchemas), &openapi); err != nil {
+		t.Fatal(err)
+	}
+	var details testSchema
+	if err := json.Unmarshal([]byte(DetailsJSONSchema), &details); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, schema := range map[string]testSchema{"details": details, "openapi": openapi["AIRACDetails"]} {
+		required := append([]string(nil), schema.Required...)
+		sort.Strings(required)
+		if !reflect.DeepEqual(required, fields) {
+			t.Errorf("%s: want required %v, got %v", name, fields, required)
+		}
+		if len(schema.Properties) != len(fields) {
+			t.Errorf("%s: want %d properties, got %d", name, len(fields), len(schema.Properties))
+		}
+	}
+
+	if openapi["AIRAC"].Pattern != `^[0-9]{2}(0[1-9]|1[0-4])$` {
+		t.Errorf("Unexpected OpenAPI pattern %s", openapi["AIRAC"].Pattern)
+	}
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+)
+
+// SectorFileHeaderLines is the number of leading lines of a sector file that
+// ReadSectorFile searches for AIRAC tags.
+const SectorFileHeaderLines = 50
+
+// nolint:gochecknoglobals
+var _sectorFileTag = regexp.MustCompile(`(?i)\bAIRAC(?:[\s_]*cycle)?[\s_]*[:#=-]?[\s_]*(\d{4}[/.-]\d{2}|\d{2}[/.-]\d{2}|\d{4})(?:\D|$)`)
+
+// FromSectorFileTag returns the AIRAC cycle that a line of a controller client
+// sector file (.sct, .ese) or profile (.prf) as used by VATSIM and IVAO
+// declares in an AIRAC tag. The tag is the word AIRAC, optionally followed by
+// "cycle" and a separator, and the cycle in one of the notations "2313",
+// "23/13" or "2023/13", e.g. ";= AIRAC 2313", "; AIRAC cycle: 23/13" or
+// "EDMM-AIRAC2313.sct".
+func FromSectorFileTag(line string) (AIRAC, error) {
+	m := _sectorFileTag.FindStringSubmatch(line)
+	if m == nil {
+		return 0, fmt.Errorf("missing AIRAC tag in %q", line)
+	}
+
+	airac, err := fromSectorFileCycle(m[1])
+	if err != nil {
+		return 0, fmt.Errorf("illegal AIRAC tag in %q: %w", line, err)
+	}
+	return airac, nil
+}
+
+func fromSectorFileCycle(cycle string) (AIRAC, error) {
+	switch len(cycle) {
+	case 4:
+		return FromString(cycle)
+	case 5:
+		return FromString(cycle[:2] + cycle[3:])
+	default:
+		year, _ := strconv.Atoi(cycle[:4])
+		ordinal, _ := strconv.Atoi(cycle[5:])
+		airac, ok := _icao.fromYearOrdinal(year, ordinal)
+		if !ok {
+			return 0, fmt.Errorf("illegal AIRAC id %q", cycle)
+		}
+		return airac, nil
+	}
+}
+
+// ReadSectorFile reads the AIRAC tags within the first SectorFileHeaderLines
+// lines of a sector file or profile and returns the declared AIRAC cycle. It
+// returns an error if there is no valid tag or if the tags contradict each
+// other.
+func ReadSectorFile(r io.Reader) (AIRAC, error) {
+	var (
+		airac   AIRAC
+		found   bool
+		scanner = bufio.NewScanner(r)
+	)
+	for line := 1; line <= SectorFileHeaderLines && scanner.Scan(); line++ {
+		if !_sectorFileTag.MatchString(scanner.Text()) {
+			continue
+		}
+		a, err := FromSectorFileTag(scanner.Text())
+		if err != nil {
+			return 0, fmt.Errorf("line %d: %w", line, err)
+		}
+		if found && a != airac {
+			return 0, fmt.Errorf("line %d: AIRAC tag %s contradicts earlier tag %s", line, a, airac)
+		}
+		airac, found = a, true
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, fmt.Errorf("missing AIRAC tag in sector file")
+	}
+	return airac, nil
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestFromSectorFileTag(t *testing.T) {
+	t.Parallel()
+
+	testt := []struct {
+		line string
+		want string
+		ok   bool
+	}{
+		{";= AIRAC 2313", "2313", true},
+		{"; AIRAC: 2313 - released 2023-12-20", "2313", true},
+		{";AIRAC cycle 23/13", "2313", true},
+		{"// airac cycle: 2023/13", "2313", true},
+		{"; AIRAC 2023-13", "2313", true},
+		{"AIRAC_2401", "2401", true},
+		{"Settings\tsector\t\\EDMM\\EDMM-AIRAC2401.sct", "2401", true},
+		{"; AIRAC#2014", "2014", true},
+		{"; AIRAC 2020/14", "2014", true},
+		{"; AIRAC 2314", "", false},
+		{"; AIRAC 2023/14", "", false},
+		{"; AIRAC 23", "", false},
+		{"; AIRAC 231300", "", false},
+		{"; Release 2313", "", false},
+		{"; NAIRAC 2313", "", false},
+	}
+	for _, tt := range testt {
+		got, err := FromSectorFileTag(tt.line)
+		if tt.ok != (err == nil) {
+			t.Errorf("%q: want ok %t, got %v", tt.line, tt.ok, err)
+			continue
+		}
+		if tt.ok && got.String() != tt.want {
+			t.Errorf("%q: want %s, got %s", tt.line, tt.want, got)
+		}
+	}
+}
+
+func TestReadSectorFile(t *testing.T) {
+	t.Parallel()
+
+	testt := []struct {
+		name string
+		data string
+		want string
+		ok   bool
+	}{
+		{"sct", ";=====\n;= EDMM sector file\n;= AIRAC 2313\n;=====\n\n[INFO]\nEDMM\n", "2313", true},
+		{"ese", ";AIRAC cycle: 23/13\n;AIRAC 2023/13\n[POSITIONS]\n", "2313", true},
+		{"prf", "Settings\tsector\t\\EDMM-AIRAC2313.sct\nSettings\tairlines\t\\ICAO_Airlines.txt\n", "2313", true},
+		{"contradicting", "; AIRAC 2313\n; AIRAC 2401\n", "", false},
+		{"illegal", "; AIRAC 2314\n", "", false},
+		{"missing", "[INFO]\nEDMM\n", "", false},
+		{"too late", strings.Repeat(";\n", SectorFileHeaderLines) + "; AIRAC 2313\n", "", false},
+	}
+	for _, tt := range testt {
+		got, err := ReadSectorFile(strings.NewReader(tt.data))
+		if tt.ok != (err == nil) {
+			t.Errorf("%s: want ok %t, got %v", tt.name, tt.ok, err)
+			continue
+		}
+		if tt.ok && got.String() != tt.want {
+			t.Errorf("%s: want %s, got %s", tt.name, tt.want, got)
+		}
+	}
+}
+
+func ExampleReadSectorFile() {
+	sct := ";= Munich sector file\n;= AIRAC 2313\n\n[INFO]\nEDMM\n"
+	a, err := ReadSectorFile(strings.NewReader(sct))
+	fmt.Println(a.LongString(), err)
+	// Output: 2313 (effective: 2023-12-28; expires: 2024-01-24) <nil>
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// MaxSerial is the serial number of the last AIRAC cycle whose effective and
+// expiry dates can be calculated (2193-03-07), see Serial.
+const MaxSerial = uint16(time.Duration(math.MaxInt64)/cycleDuration) - 1
+
+// Serial returns the serial number of this AIRAC cycle, i.e. the number of
+// cycles since the internal epoch (1901-01-10).
+func (a AIRAC) Serial() uint16 {
+	return uint16(a)
+}
+
+// FromSerial returns the AIRAC cycle with the serial number serial. It returns
+// an error if serial exceeds MaxSerial.
+func FromSerial(serial uint16) (AIRAC, error) {
+	if serial > MaxSerial {
+		return 0, fmt.Errorf("illegal AIRAC serial %d", serial)
+	}
+	return AIRAC(serial), nil
+}
+
+// Int returns the identifier of this AIRAC cycle as an integer, e.g. 2313 for
+// "2313" or 101 for "0101".
+func (a AIRAC) Int() int {
+	return a.Year()%100*100 + a.Ordinal()
+}
+
+// FromInt returns the AIRAC cycle that matches the identifier in integer form
+// <yyoo> like FromString, e.g. 2313 for "2313" or 101 for "0101".
+func FromInt(yyoo int) (AIRAC, error) {
+	if yyoo < 0 || yyoo > 9999 {
+		return 0, fmt.Errorf("illegal AIRAC id %d", yyoo)
+	}
+	return FromString(fmt.Sprintf("%04d", yyoo))
+}
+
+// Validate reports whether this AIRAC cycle round trips through String and
+// FromString, i.e. whether it lies within IdentifierRange (1964-2063) where its
+// two-digit identifier is unambiguous, and whether its serial does not exceed
+// MaxSerial. Systems that persist identifiers may use it to detect values
+// that won't round trip before writing them.
+func (a AIRAC) Validate() error {
+	if uint16(a) > MaxSerial {
+		return fmt.Errorf("AIRAC serial %d exceeds the supported maximum %d", uint16(a), MaxSerial)
+	}
+	if !IdentifierRange.Contains(a) {
+		return fmt.Errorf("AIRAC cycle %d/%02d (serial %d) is outside of the unambiguous identifier range %d-%d",
+			a.Year(), a.Ordinal(), uint16(a), IdentifierRange.First.Year(), IdentifierRange.Last.Year())
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSerial(t *testing.T) {
+	t.Parallel()
+
+	for _, serial := range []uint16{0, 1604, MaxSerial} {
+		a, err := FromSerial(serial)
+		if err != nil {
+			t.Errorf("Serial %d: %v", serial, err)
+			continue
+		}
+		if a.Serial() != serial {
+			t.Errorf("Serial %d: round trip yields %d", serial, a.Serial())
+		}
+	}
+
+	last := AIRAC(MaxSerial)
+	if !last.Effective().Before((last + 1).Effective()) {
+		t.Errorf("Expiry of the last cycle %s overflows", last.LongString())
+	}
+	if got := last.LongString(); got != "9303 (effective: 2193-03-07; expires: 2193-04-03)" {
+		t.Errorf("Unexpected last cycle %s", got)
+	}
+
+	for _, serial := range []uint16{MaxSerial + 1, math.MaxUint16} {
+		if a, err := FromSerial(serial); err == nil {
+			t.Errorf("Serial %d yields %s, but should have raised an error", serial, a)
+		}
+	}
+}
+
+func TestInt(t *testing.T) {
+	t.Parallel()
+
+	testt := []struct {
+		yyoo  int
+		want  string
+		valid bool
+	}{
+		{2313, "2313", true},
+		{101, "0101", true},
+		{9913, "9913", true},
+		{2314, "", false},
+		{0, "", false},
+		{-101, "", false},
+		{10101, "", false},
+	}
+
+	for _, tt := range testt {
+		got, err := FromInt(tt.yyoo)
+		if tt.valid != (err == nil) {
+			t.Errorf("%d: want valid %t, got error %v", tt.yyoo, tt.valid, err)
+			continue
+		}
+		if !tt.valid {
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("%d: want %s, got %s", tt.yyoo, tt.want, got)
+		}
+		if got.Int() != tt.yyoo {
+			t.Errorf("%d: round trip yields %d", tt.yyoo, got.Int())
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, a := range []AIRAC{IdentifierRange.First, FromStringMust("2313"), IdentifierRange.Last} {
+		if err := a.Validate(); err != nil {
+			t.Errorf("%s: %v", a, err)
+		}
+	}
+
+	for _, a := range []AIRAC{0, IdentifierRange.First - 1, IdentifierRange.Last + 1, AIRAC(MaxSerial) + 1, math.MaxUint16} {
+		if err := a.Validate(); err == nil {
+			t.Errorf("Serial %d should not be valid", uint16(a))
+		} else {
+			t.Logf("Serial %d rightfully yields error: %v", uint16(a), err)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"math/bits"
+)
+
+// Set is a set of AIRAC cycles backed by a bitset. The zero value is an empty
+// set ready to use. A Set must not be copied after first use; use Clone
+// instead.
+type Set struct {
+	words []uint64
+}
+
+// NewSet returns a set of the given cycles.
+func NewSet(cycles ...AIRAC) *Set {
+	s := new(Set)
+	s.Add(cycles...)
+	return s
+}
+
+// Add adds the given cycles to this set.
+func (s *Set) Add(cycles ...AIRAC) {
+	for _, a := range cycles {
+		w := int(a / 64)
+		if w >= len(s.words) {
+			s.grow(w + 1)
+		}
+		s.words[w] |= 1 << (a % 64)
+	}
+}
+
+// Remove removes the given cycles from this set.
+func (s *Set) Remove(cycles ...AIRAC) {
+	for _, a := range cycles {
+		if w := int(a / 64); w < len(s.words) {
+			s.words[w] &^= 1 << (a % 64)
+		}
+	}
+	s.trim()
+}
+
+// Contains reports whether cycle a is an element of this set.
+func (s *Set) Contains(a AIRAC) bool {
+	w := int(a / 64)
+	return w < len(s.words) && s.words[w]&(1<<(a%64)) != 0
+}
+
+// Len returns the number of cycles in this set.
+func (s *Set) Len() int {
+	n := 0
+	for _, w := range s.words {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// Min returns the earliest cycle of this set. It returns false if the set is
+// empty.
+func (s *Set) Min() (AIRAC, bool) {
+	for i, w := range s.words {
+		if w != 0 {
+			return AIRAC(i*64 + bits.TrailingZeros64(w)), true
+		}
+	}
+	return 0, false
+}
+
+// Max returns the latest cycle of this set. It returns false if the set is
+// empty.
+func (s *Set) Max() (AIRAC, bool) {
+	for i := len(s.words) - 1; i >= 0; i-- {
+		if w := s.words[i]; w != 0 {
+			return AIRAC(i*64 + 63 - bits.LeadingZeros64(w)), true
+		}
+	}
+	return 0, false
+}
+
+// Each calls f for every cycle of this set in chronological order until f
+// returns false.
+func (s *Set) Each(f func(a AIRAC) bool) {
+	for i, w := range s.words {
+		for w != 0 {
+			b := bits.TrailingZeros64(w)
+			if !f(AIRAC(i*64 + b)) {
+				return
+			}
+			w &^= 1 << uint(b)
+		}
+	}
+}
+
+// Cycles returns the cycles of this set in chronological order.
+func (s *Set) Cycles() []AIRAC {
+	cycles := make([]AIRAC, 0, s.Len())
+	s.Each(func(a AIRAC) bool {
+		cycles = append(cycles, a)
+		return true
+	})
+	return cycles
+}
+
+// Clone returns a copy of this set.
+func (s *Set) Clone() *Set {
+	return &Set{words: append([]uint64(nil), s.words...)}
+}
+
+// Equal reports whether this set and o contain the same cycles.
+func (s *Set) Equal(o *Set) bool {
+	if len(s.words) != len(o.words) {
+		return false
+	}
+	for i := range s.words {
+		if s.words[i] != o.words[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Union returns a new set of the cycles that are in this set or in o.
+func (s *Set) Union(o *Set) *Set {
+	u := s.Clone()
+	if len(o.words) > len(u.words) {
+		u.grow(len(o.words))
+	}
+	for i, w := range o.words {
+		u.words[i] |= w
+	}
+	return u
+}
+
+// Intersect returns a new set of the cycles that are in both this set and o.
+func (s *Set) Intersect(o *Set) *Set {
+	n := len(s.words)
+	if len(o.words) < n {
+		n = len(o.words)
+	}
+	i := &Set{words: make([]uint64, n)}
+	for k := range i.words {
+		i.words[k] = s.words[k] & o.words[k]
+	}
+	i.trim()
+	return i
+}
+
+// Difference returns a new set of the cycles that are in this set but not in
+// o.
+func (s *Set) Difference(o *Set) *Set {
+	d := s.Clone()
+	for i := 0; i < len(d.words) && i < len(o.words); i++ {
+		d.words[i] &^= o.words[i]
+	}
+	d.trim()
+	return d
+}
+
+func (s *Set) grow(n int) {
+	words := make([]uint64, n)
+	copy(words, s.words)
+	s.words = words
+}
+
+// trim drops trailing empty words, so that equal sets have equal words.
+func (s *Set) trim() {
+	n := len(s.words)
+	for n > 0 && s.words[n-1] == 0 {
+		n--
+	}
+	s.words = s.words[:n]
+}
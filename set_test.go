@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSet(t *testing.T) {
+	t.Parallel()
+
+	var s Set
+	if _, ok := s.Min(); ok {
+		t.Error("Empty set should not have a minimum")
+	}
+	if _, ok := s.Max(); ok {
+		t.Error("Empty set should not have a maximum")
+	}
+
+	a, b, c := FromStringMust("2301"), FromStringMust("2313"), AIRAC(0)
+	s.Add(a, b, b, c)
+	if got := s.Len(); got != 3 {
+		t.Errorf("Want 3 cycles, got %d", got)
+	}
+	if !s.Contains(a) || !s.Contains(b) || !s.Contains(c) || s.Contains(a+1) {
+		t.Errorf("Unexpected set contents %v", s.Cycles())
+	}
+	if min, _ := s.Min(); min != c {
+		t.Errorf("Want minimum %s, got %s", c, min)
+	}
+	if max, _ := s.Max(); max != b {
+		t.Errorf("Want maximum %s, got %s", b, max)
+	}
+
+	s.Remove(b, FromStringMust("6313"))
+	if max, _ := s.Max(); max != a {
+		t.Errorf("Want maximum %s, got %s", a, max)
+	}
+	if !s.Equal(NewSet(c, a)) {
+		t.Errorf("Want [%s %s], got %v", c, a, s.Cycles())
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	t.Parallel()
+
+	x := NewSet(FromStringMust("2301"), FromStringMust("2302"), FromStringMust("2401"))
+	y := NewSet(FromStringMust("2302"), FromStringMust("2303"))
+
+	testt := []struct {
+		name string
+		got  *Set
+		want string
+	}{
+		{"union", x.Union(y), "[2301 2302 2303 2401]"},
+		{"union reverse", y.Union(x), "[2301 2302 2303 2401]"},
+		{"intersect", x.Intersect(y), "[2302]"},
+		{"difference", x.Difference(y), "[2301 2401]"},
+		{"difference reverse", y.Difference(x), "[2303]"},
+		{"intersect empty", x.Intersect(new(Set)), "[]"},
+	}
+
+	for _, tt := range testt {
+		if got := fmt.Sprint(tt.got.Cycles()); got != tt.want {
+			t.Errorf("%s: want %s, got %s", tt.name, tt.want, got)
+		}
+	}
+
+	if got := fmt.Sprint(x.Cycles()); got != "[2301 2302 2401]" {
+		t.Errorf("Operations must not modify operands, got %s", got)
+	}
+	if !x.Difference(x).Equal(new(Set)) {
+		t.Error("Difference with itself should equal the empty set")
+	}
+}
+
+func ExampleSet() {
+	s := NewSet(FromStringMust("2313"), FromStringMust("2301"))
+	s.Add(FromStringMust("2307"))
+
+	s.Each(func(a AIRAC) bool {
+		fmt.Println(a.LongString())
+		return true
+	})
+
+	// Output:
+	// 2301 (effective: 2023-01-26; expires: 2023-02-22)
+	// 2307 (effective: 2023-07-13; expires: 2023-08-09)
+	// 2313 (effective: 2023-12-28; expires: 2024-01-24)
+}
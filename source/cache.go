@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package source
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jwkohnen/airac"
+)
+
+// Refreshes of a Cache are aborted after CacheFetchTimeout. After a failed
+// refresh, the next one is attempted no earlier than CacheRetryDelay later, or
+// the TTL if that is shorter.
+const (
+	CacheFetchTimeout = time.Minute
+	CacheRetryDelay   = time.Minute
+)
+
+// Cache is a ScheduleSource that caches the schedule of another source for a
+// fixed time. If refreshing an expired schedule fails, the last schedule is
+// served until the refresh succeeds, so that a flaky upstream does not break
+// its users; only if there is no schedule yet the error is returned. It is
+// safe for concurrent use.
+//
+// A refresh runs in the background, detached from the context of the caller
+// that triggered it, and concurrent callers share it. While it runs, callers
+// are served the expired schedule; only callers without any schedule wait for
+// it, as long as their context allows.
+type Cache struct {
+	src          ScheduleSource
+	ttl          time.Duration
+	retryDelay   time.Duration
+	fetchTimeout time.Duration
+	now          func() time.Time
+
+	mu         sync.Mutex
+	schedule   []Cycle
+	fetched    time.Time
+	retry      time.Time
+	err        error
+	inflight   chan struct{}
+	generation int
+}
+
+// NewCache returns a source that caches the schedule of src for ttl.
+func NewCache(src ScheduleSource, ttl time.Duration) *Cache {
+	retryDelay := CacheRetryDelay
+	if ttl < retryDelay {
+		retryDelay = ttl
+	}
+	return &Cache{src: src, ttl: ttl, retryDelay: retryDelay, fetchTimeout: CacheFetchTimeout, now: time.Now}
+}
+
+// Schedule implements ScheduleSource.
+func (c *Cache) Schedule(ctx context.Context) ([]Cycle, error) {
+	c.mu.Lock()
+	now := c.now()
+	fresh := !c.fetched.IsZero() && now.Sub(c.fetched) < c.ttl
+	if !fresh && now.Before(c.retry) && c.fetched.IsZero() {
+		err := c.err
+		c.mu.Unlock()
+		return nil, err
+	}
+	if !fresh && !now.Before(c.retry) && c.inflight == nil {
+		c.inflight = make(chan struct{})
+		go c.refresh(c.inflight, c.generation)
+	}
+	if !c.fetched.IsZero() {
+		schedule := append([]Cycle(nil), c.schedule...)
+		c.mu.Unlock()
+		return schedule, nil
+	}
+	inflight := c.inflight
+	c.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-inflight:
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.fetched.IsZero() {
+		return nil, c.err
+	}
+	return append([]Cycle(nil), c.schedule...), nil
+}
+
+// refresh fetches the schedule from the underlying source and closes done
+// when it is stored. Results of refreshes started before an Invalidate are
+// discarded.
+func (c *Cache) refresh(done chan struct{}, generation int) {
+	defer close(done)
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.fetchTimeout)
+	defer cancel()
+	schedule, err := c.src.Schedule(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.inflight == done {
+		c.inflight = nil
+	}
+	if generation != c.generation {
+		return
+	}
+	if err != nil {
+		c.err, c.retry = err, c.now().Add(c.retryDelay)
+		return
+	}
+	c.schedule, c.fetched, c.err, c.retry = schedule, c.now(), nil, time.Time{}
+}
+
+// Current implements ScheduleSource with the cached schedule.
+func (c *Cache) Current(ctx context.Context, now time.Time) (airac.AIRAC, error) {
+	schedule, err := c.Schedule(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return CurrentOf(schedule, now)
+}
+
+// Invalidate discards the cached schedule, so that the next call fetches it
+// from the underlying source.
+func (c *Cache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.schedule, c.fetched, c.retry, c.err = nil, time.Time{}, time.Time{}, nil
+	c.inflight = nil
+	c.generation++
+}
@@ -0,0 +1,219 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package source
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jwkohnen/airac"
+)
+
+type countingSource struct {
+	mu    sync.Mutex
+	calls int
+	err   error
+	block chan struct{}
+}
+
+func (s *countingSource) Schedule(ctx context.Context) ([]Cycle, error) {
+	s.mu.Lock()
+	s.calls++
+	err, block := s.err, s.block
+	s.mu.Unlock()
+
+	if block != nil {
+		<-block
+	}
+	if err != nil {
+		return nil, err
+	}
+	a := airac.FromStringMust("2401")
+	return []Cycle{{AIRAC: a, Effective: a.Effective()}}, nil
+}
+
+func (s *countingSource) Current(ctx context.Context, now time.Time) (airac.AIRAC, error) {
+	schedule, err := s.Schedule(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return CurrentOf(schedule, now)
+}
+
+func (s *countingSource) set(err error, block chan struct{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err, s.block = err, block
+}
+
+func (s *countingSource) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls
+}
+
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *testClock) Add(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// waitRefresh waits for the background refresh of c, if any.
+func waitRefresh(c *Cache) {
+	c.mu.Lock()
+	inflight := c.inflight
+	c.mu.Unlock()
+	if inflight != nil {
+		<-inflight
+	}
+}
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	clock := &testClock{now: date("2024-02-01T00:00:00Z")}
+	src := &countingSource{}
+	c := NewCache(src, time.Hour)
+	c.now = clock.Now
+
+	for i := 0; i < 3; i++ {
+		if got, err := c.Current(ctx, clock.Now()); err != nil || got.String() != "2401" {
+			t.Fatalf("Want 2401, got %s (%v)", got, err)
+		}
+	}
+	if got := src.count(); got != 1 {
+		t.Errorf("Want 1 call, got %d", got)
+	}
+
+	clock.Add(time.Hour)
+	src.set(errors.New("upstream down"), nil)
+	if _, err := c.Schedule(ctx); err != nil {
+		t.Errorf("Want the stale schedule, got %v", err)
+	}
+	waitRefresh(c)
+	if got := src.count(); got != 2 {
+		t.Errorf("Want 2 calls, got %d", got)
+	}
+
+	c.Invalidate()
+	if _, err := c.Schedule(ctx); err == nil {
+		t.Error("Without a schedule the error should have been returned")
+	}
+
+	src.set(nil, nil)
+	if _, err := c.Schedule(ctx); err == nil || src.count() != 3 {
+		t.Errorf("Want the last error during the retry delay, got %d calls (%v)", src.count(), err)
+	}
+
+	clock.Add(CacheRetryDelay)
+	if _, err := c.Schedule(ctx); err != nil || src.count() != 4 {
+		t.Errorf("Want a refresh, got %d calls (%v)", src.count(), err)
+	}
+}
+
+func TestCacheRefreshWithoutLock(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	clock := &testClock{now: date("2024-02-01T00:00:00Z")}
+	src := &countingSource{}
+	c := NewCache(src, time.Hour)
+	c.now = clock.Now
+
+	if _, err := c.Schedule(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	block := make(chan struct{})
+	src.set(nil, block)
+	clock.Add(time.Hour)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if schedule, err := c.Schedule(ctx); err != nil || len(schedule) != 1 {
+				t.Errorf("Want the stale schedule, got %v (%v)", schedule, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	close(block)
+	waitRefresh(c)
+	if got := src.count(); got != 2 {
+		t.Errorf("Want a single refresh, got %d calls", got-1)
+	}
+}
+
+func TestCacheBackoff(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	clock := &testClock{now: date("2024-02-01T00:00:00Z")}
+	src := &countingSource{err: errors.New("upstream down")}
+	c := NewCache(src, time.Hour)
+	c.now = clock.Now
+
+	for i := 0; i < 3; i++ {
+		if _, err := c.Schedule(ctx); err == nil {
+			t.Error("should have raised an error")
+		}
+	}
+	if got := src.count(); got != 1 {
+		t.Errorf("Want 1 call during the retry delay, got %d", got)
+	}
+
+	clock.Add(CacheRetryDelay)
+	if _, err := c.Schedule(ctx); err == nil {
+		t.Error("should have raised an error")
+	}
+	if got := src.count(); got != 2 {
+		t.Errorf("Want 2 calls after the retry delay, got %d", got)
+	}
+}
+
+func TestCacheContext(t *testing.T) {
+	t.Parallel()
+
+	block := make(chan struct{})
+	defer close(block)
+	src := &countingSource{block: block}
+	c := NewCache(src, time.Hour)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := c.Schedule(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Want %v, got %v", context.DeadlineExceeded, err)
+	}
+}
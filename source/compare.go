@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package source
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jwkohnen/airac"
+)
+
+// Mismatch is a cycle whose effective dates differ between two sources. A zero
+// date denotes that a source does not announce the cycle at all.
+type Mismatch struct {
+	AIRAC airac.AIRAC
+	A, B  time.Time
+}
+
+// Shift returns how much later the cycle becomes effective in B than in A. It
+// is zero if either source does not announce the cycle.
+func (m Mismatch) Shift() time.Duration {
+	if m.A.IsZero() || m.B.IsZero() {
+		return 0
+	}
+	return m.B.Sub(m.A)
+}
+
+// String returns a human-readable description of this mismatch.
+func (m Mismatch) String() string {
+	switch {
+	case m.A.IsZero():
+		return fmt.Sprintf("%s: only in B (effective %s)", m.AIRAC, m.B.Format(time.RFC3339))
+	case m.B.IsZero():
+		return fmt.Sprintf("%s: only in A (effective %s)", m.AIRAC, m.A.Format(time.RFC3339))
+	default:
+		return fmt.Sprintf("%s: effective %s in A, %s in B (shifted by %s)",
+			m.AIRAC, m.A.Format(time.RFC3339), m.B.Format(time.RFC3339), m.Shift())
+	}
+}
+
+// Compare returns the cycles of r whose effective dates differ between the
+// sources a and b in chronological order, e.g. to detect that an upstream feed
+// silently shifted a date against the computed schedule:
+//
+//	mismatches, err := source.Compare(ctx, source.NewComputed(airac.ICAO(), r), feed, r)
+func Compare(ctx context.Context, a, b ScheduleSource, r airac.Range) ([]Mismatch, error) {
+	scheduleA, err := effectiveDates(ctx, a, r)
+	if err != nil {
+		return nil, fmt.Errorf("schedule A: %w", err)
+	}
+	scheduleB, err := effectiveDates(ctx, b, r)
+	if err != nil {
+		return nil, fmt.Errorf("schedule B: %w", err)
+	}
+
+	var mismatches []Mismatch
+	for i := 0; i < r.Len(); i++ {
+		cycle := r.First + airac.AIRAC(i)
+		ea, eb := scheduleA[cycle], scheduleB[cycle]
+		if !ea.Equal(eb) {
+			mismatches = append(mismatches, Mismatch{AIRAC: cycle, A: ea, B: eb})
+		}
+	}
+	return mismatches, nil
+}
+
+func effectiveDates(ctx context.Context, src ScheduleSource, r airac.Range) (map[airac.AIRAC]time.Time, error) {
+	schedule, err := src.Schedule(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dates := make(map[airac.AIRAC]time.Time, r.Len())
+	for _, c := range schedule {
+		if r.Contains(c.AIRAC) {
+			dates[c.AIRAC] = c.Effective
+		}
+	}
+	return dates, nil
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package source
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jwkohnen/airac"
+)
+
+// staticSource announces a fixed schedule.
+type staticSource []Cycle
+
+func (s staticSource) Schedule(ctx context.Context) ([]Cycle, error) {
+	if s == nil {
+		return nil, errors.New("unavailable")
+	}
+	return s, nil
+}
+
+func (s staticSource) Current(ctx context.Context, now time.Time) (airac.AIRAC, error) {
+	return CurrentOf(s, now)
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	r, _ := airac.RangeFromString("2401-2404")
+	computed := NewComputed(airac.ICAO(), r)
+
+	c2401, c2402, c2403 := airac.FromStringMust("2401"), airac.FromStringMust("2402"), airac.FromStringMust("2403")
+	feed := staticSource{
+		{c2401, c2401.Effective()},
+		{c2402, c2402.Effective().AddDate(0, 0, 1)},
+		{c2403, c2403.Effective()},
+		{c2403 + 2, (c2403 + 2).Effective()}, // outside of r
+	}
+
+	mismatches, err := Compare(context.Background(), computed, feed, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mismatches) != 2 {
+		t.Fatalf("Want 2 mismatches, got %v", mismatches)
+	}
+	if m := mismatches[0]; m.AIRAC != c2402 || m.Shift() != 24*time.Hour {
+		t.Errorf("Want 2402 shifted by a day, got %s", m)
+	}
+	if m := mismatches[1]; m.AIRAC.String() != "2404" || !m.B.IsZero() || m.Shift() != 0 {
+		t.Errorf("Want 2404 only in A, got %s", m)
+	}
+	if got, want := mismatches[0].String(), "2402: effective 2024-02-22T00:00:00Z in A, 2024-02-23T00:00:00Z in B (shifted by 24h0m0s)"; got != want {
+		t.Errorf("Want %q, got %q", want, got)
+	}
+	if got, want := mismatches[1].String(), "2404: only in A (effective 2024-04-18T00:00:00Z)"; got != want {
+		t.Errorf("Want %q, got %q", want, got)
+	}
+
+	cal, err := airac.ICAO().WithOffset(time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mismatches, err = Compare(context.Background(), computed, NewComputed(cal, r), r)
+	if err != nil || len(mismatches) != 4 || mismatches[3].Shift() != time.Minute {
+		t.Errorf("Want 4 mismatches shifted by a minute, got %v (%v)", mismatches, err)
+	}
+
+	if _, err := Compare(context.Background(), staticSource(nil), computed, r); err == nil {
+		t.Error("An unavailable source should have raised an error")
+	}
+	if _, err := Compare(context.Background(), computed, staticSource(nil), r); err == nil {
+		t.Error("An unavailable source should have raised an error")
+	}
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package source
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/jwkohnen/airac"
+)
+
+// maxFeedSize limits the size of a schedule feed.
+const maxFeedSize = 1 << 20
+
+// HTTPSource is a ScheduleSource that fetches the schedule from a JSON feed
+// on every call. The feed is an array of objects with the members
+// "identifier" and "effective", e.g. the output of airac.Schedule:
+//
+//	[{"identifier": "2401", "effective": "2024-01-25"}, ...]
+//
+// The effective date is either "YYYY-MM-DD" or RFC 3339.
+type HTTPSource struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPSource returns a source that fetches the feed at url with client. If
+// client is nil, http.DefaultClient is used.
+func NewHTTPSource(url string, client *http.Client) *HTTPSource {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &HTTPSource{url: url, client: client}
+}
+
+type feedCycle struct {
+	Identifier string `json:"identifier"`
+	Effective  string `json:"effective"`
+}
+
+// Schedule implements ScheduleSource.
+func (s *HTTPSource) Schedule(ctx context.Context) ([]Cycle, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching AIRAC schedule from %s: %s", s.url, resp.Status)
+	}
+
+	var feed []feedCycle
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxFeedSize)).Decode(&feed); err != nil {
+		return nil, fmt.Errorf("decoding AIRAC schedule from %s: %w", s.url, err)
+	}
+
+	schedule := make([]Cycle, 0, len(feed))
+	for _, f := range feed {
+		a, err := airac.FromString(f.Identifier)
+		if err != nil {
+			return nil, fmt.Errorf("decoding AIRAC schedule from %s: %w", s.url, err)
+		}
+		effective, err := parseEffective(f.Effective)
+		if err != nil {
+			return nil, fmt.Errorf("decoding AIRAC schedule from %s: cycle %s: %w", s.url, a, err)
+		}
+		schedule = append(schedule, Cycle{AIRAC: a, Effective: effective})
+	}
+	sort.SliceStable(schedule, func(i, j int) bool { return schedule[i].Effective.Before(schedule[j].Effective) })
+
+	return schedule, nil
+}
+
+// Current implements ScheduleSource.
+func (s *HTTPSource) Current(ctx context.Context, now time.Time) (airac.AIRAC, error) {
+	schedule, err := s.Schedule(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return CurrentOf(schedule, now)
+}
+
+func parseEffective(s string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("illegal effective date %q", s)
+	}
+	return t.UTC(), nil
+}
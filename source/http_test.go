@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package source
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHTTPSource(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+			_, _ = w.Write([]byte(`[
+				{"identifier": "2402", "effective": "2024-02-22T00:01:00Z", "serial": 1607},
+				{"identifier": "2401", "effective": "2024-01-25"}
+			]`))
+		case "/bad-id":
+			_, _ = w.Write([]byte(`[{"identifier": "2415", "effective": "2024-01-25"}]`))
+		case "/bad-date":
+			_, _ = w.Write([]byte(`[{"identifier": "2401", "effective": "25.01.2024"}]`))
+		case "/bad-json":
+			_, _ = w.Write([]byte(`{`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	src := NewHTTPSource(srv.URL+"/ok", srv.Client())
+	schedule, err := src.Schedule(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(schedule) != 2 || schedule[0].AIRAC.String() != "2401" || schedule[1].AIRAC.String() != "2402" {
+		t.Fatalf("Want 2401 and 2402 in order, got %v", schedule)
+	}
+	if got := schedule[1].Effective; !got.Equal(date("2024-02-22T00:01:00Z")) {
+		t.Errorf("Want the announced effective date, got %s", got)
+	}
+
+	got, err := src.Current(context.Background(), date("2024-02-22T00:00:30Z"))
+	if err != nil || got.String() != "2401" {
+		t.Errorf("Want 2401, got %s (%v)", got, err)
+	}
+
+	for _, path := range []string{"/bad-id", "/bad-date", "/bad-json", "/missing"} {
+		if _, err := NewHTTPSource(srv.URL+path, nil).Schedule(context.Background()); err == nil {
+			t.Errorf("%s should have raised an error", path)
+		}
+	}
+}
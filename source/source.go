@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package source abstracts where the AIRAC schedule comes from. Usually the
+// schedule is computed, but deployments that must follow an authoritative
+// upstream feed can use an HTTPSource instead, typically behind a Cache.
+// Compare detects where two sources disagree.
+package source
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jwkohnen/airac"
+)
+
+// Cycle is an announced AIRAC cycle and its effective date.
+type Cycle struct {
+	AIRAC     airac.AIRAC
+	Effective time.Time
+}
+
+// ScheduleSource provides the announced AIRAC cycles.
+type ScheduleSource interface {
+	// Schedule returns the announced cycles in chronological order.
+	Schedule(ctx context.Context) ([]Cycle, error)
+
+	// Current returns the cycle that is effective at now.
+	Current(ctx context.Context, now time.Time) (airac.AIRAC, error)
+}
+
+// CurrentOf returns the cycle of schedule that is effective at now, i.e. the
+// newest cycle that became effective at or before now. schedule must be in
+// chronological order.
+func CurrentOf(schedule []Cycle, now time.Time) (airac.AIRAC, error) {
+	i := sort.Search(len(schedule), func(i int) bool { return schedule[i].Effective.After(now) })
+	if i == 0 {
+		return 0, fmt.Errorf("no announced AIRAC cycle is effective at %s", now.Format(time.RFC3339))
+	}
+	return schedule[i-1].AIRAC, nil
+}
+
+// Computed is a ScheduleSource that computes the cycles of a range with a
+// calendar instead of fetching them.
+type Computed struct {
+	calendar airac.Calendar
+	r        airac.Range
+}
+
+// NewComputed returns a source that announces the cycles of r with the
+// effective dates of calendar c, e.g. NewComputed(airac.ICAO(), r).
+func NewComputed(c airac.Calendar, r airac.Range) *Computed {
+	return &Computed{calendar: c, r: r}
+}
+
+// Schedule implements ScheduleSource.
+func (c *Computed) Schedule(ctx context.Context) ([]Cycle, error) {
+	schedule := make([]Cycle, 0, c.r.Len())
+	for i := 0; i < c.r.Len(); i++ {
+		a := c.r.First + airac.AIRAC(i)
+		schedule = append(schedule, Cycle{AIRAC: a, Effective: c.calendar.Effective(a)})
+	}
+	return schedule, nil
+}
+
+// Current implements ScheduleSource. It returns an error if the cycle
+// effective at now is outside of the range of this source.
+func (c *Computed) Current(ctx context.Context, now time.Time) (airac.AIRAC, error) {
+	a := c.calendar.FromDate(now)
+	if now.Before(c.calendar.Effective(c.r.First)) || !c.r.Contains(a) {
+		return 0, fmt.Errorf("no announced AIRAC cycle is effective at %s", now.Format(time.RFC3339))
+	}
+	return a, nil
+}
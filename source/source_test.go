@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package source
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jwkohnen/airac"
+)
+
+func date(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestCurrentOf(t *testing.T) {
+	t.Parallel()
+
+	schedule := []Cycle{
+		{airac.FromStringMust("2401"), date("2024-01-25T00:00:00Z")},
+		{airac.FromStringMust("2402"), date("2024-02-23T00:00:00Z")}, // announced a day late
+	}
+	testt := []struct {
+		now  string
+		want string
+		ok   bool
+	}{
+		{"2024-01-24T23:59:59Z", "", false},
+		{"2024-01-25T00:00:00Z", "2401", true},
+		{"2024-02-22T12:00:00Z", "2401", true},
+		{"2024-02-23T00:00:00Z", "2402", true},
+		{"2025-01-01T00:00:00Z", "2402", true},
+	}
+	for _, tt := range testt {
+		got, err := CurrentOf(schedule, date(tt.now))
+		if !tt.ok {
+			if err == nil {
+				t.Errorf("%s: got %s, but should have raised an error", tt.now, got)
+			}
+			continue
+		}
+		if err != nil || got.String() != tt.want {
+			t.Errorf("%s: want %s, got %s (%v)", tt.now, tt.want, got, err)
+		}
+	}
+}
+
+func TestComputed(t *testing.T) {
+	t.Parallel()
+
+	r, _ := airac.RangeFromString("2401-2413")
+	src := NewComputed(airac.ICAO(), r)
+
+	schedule, err := src.Schedule(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(schedule) != 13 {
+		t.Fatalf("Want 13 cycles, got %d", len(schedule))
+	}
+	for _, c := range schedule {
+		if !c.Effective.Equal(c.AIRAC.Effective()) {
+			t.Errorf("%s: want effective %s, got %s", c.AIRAC, c.AIRAC.Effective(), c.Effective)
+		}
+	}
+
+	for _, tt := range []struct {
+		now  string
+		want string
+		ok   bool
+	}{
+		{"2024-01-24T00:00:00Z", "", false},
+		{"2024-06-01T00:00:00Z", "2405", true},
+		{"2025-01-01T00:00:00Z", "2413", true},
+		{"2025-01-30T00:00:00Z", "", false},
+	} {
+		got, err := src.Current(context.Background(), date(tt.now))
+		if !tt.ok {
+			if err == nil {
+				t.Errorf("%s: got %s, but should have raised an error", tt.now, got)
+			}
+			continue
+		}
+		if err != nil || got.String() != tt.want {
+			t.Errorf("%s: want %s, got %s (%v)", tt.now, tt.want, got, err)
+		}
+	}
+}
+
+// static assert
+var (
+	_ ScheduleSource = (*Computed)(nil)
+	_ ScheduleSource = (*HTTPSource)(nil)
+	_ ScheduleSource = (*Cache)(nil)
+)
@@ -0,0 +1,163 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+)
+
+// AIRAC itself does not implement driver.Valuer or sql.Scanner, so that
+// database/sql stores it as its serial number in integer columns like any
+// uint16. The types below adapt AIRAC cycles to ORMs like GORM and ent:
+// AIRACIdentifier for string columns, AIRACSerial for integer columns and
+// NullAIRAC for nullable string columns.
+
+// Value implements driver.Valuer. AIRACIdentifier values are stored as their
+// identifier "YYOO" in string columns. It returns an error if the identifier
+// does not round trip, see AIRAC.Validate.
+//
+// With GORM, declare the column size, e.g. `gorm:"size:4"`. With ent, use
+// field.String("cycle").GoType(airac.AIRACIdentifier(0)).
+func (i AIRACIdentifier) Value() (driver.Value, error) {
+	text, err := i.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+// Scan implements sql.Scanner for string columns like FromString.
+func (i *AIRACIdentifier) Scan(src interface{}) error {
+	var id []byte
+	switch v := src.(type) {
+	case string:
+		id = []byte(v)
+	case []byte:
+		id = v
+	case nil:
+		return fmt.Errorf("cannot scan NULL into AIRACIdentifier; use NullAIRAC")
+	default:
+		return fmt.Errorf("cannot scan %T into AIRACIdentifier", src)
+	}
+	return i.UnmarshalText(id)
+}
+
+// GormDataType returns the generic GORM data type of AIRACIdentifier columns.
+func (AIRACIdentifier) GormDataType() string {
+	return "string"
+}
+
+// AIRACSerial is an AIRAC cycle that is stored as its serial number in integer
+// columns. With ent, use field.Uint16("cycle").GoType(airac.AIRACSerial(0)).
+type AIRACSerial AIRAC
+
+// Value implements driver.Valuer.
+func (s AIRACSerial) Value() (driver.Value, error) {
+	return int64(s), nil
+}
+
+// Scan implements sql.Scanner for integer columns.
+func (s *AIRACSerial) Scan(src interface{}) error {
+	var serial uint64
+	switch v := src.(type) {
+	case int64:
+		if v < 0 || v > int64(MaxSerial) {
+			return fmt.Errorf("illegal AIRAC serial %d", v)
+		}
+		serial = uint64(v)
+	case string:
+		return s.Scan([]byte(v))
+	case []byte:
+		var err error
+		if serial, err = strconv.ParseUint(string(v), 10, 16); err != nil {
+			return fmt.Errorf("illegal AIRAC serial %q", v)
+		}
+	case nil:
+		return fmt.Errorf("cannot scan NULL into AIRACSerial; use *AIRACSerial")
+	default:
+		return fmt.Errorf("cannot scan %T into AIRACSerial", src)
+	}
+
+	airac, err := FromSerial(uint16(serial))
+	if err != nil {
+		return err
+	}
+	*s = AIRACSerial(airac)
+	return nil
+}
+
+// AIRAC returns the AIRAC cycle of this value.
+func (s AIRACSerial) AIRAC() AIRAC {
+	return AIRAC(s)
+}
+
+// String returns the identifier of this AIRAC cycle like AIRAC.String.
+func (s AIRACSerial) String() string {
+	return AIRAC(s).String()
+}
+
+// GormDataType returns the generic GORM data type of AIRACSerial columns.
+func (AIRACSerial) GormDataType() string {
+	return "uint"
+}
+
+// NullAIRAC is an AIRAC cycle that may be NULL, stored like AIRACIdentifier
+// in nullable string columns. The zero value is NULL.
+type NullAIRAC struct {
+	AIRAC AIRAC
+	Valid bool
+}
+
+// Value implements driver.Valuer.
+func (n NullAIRAC) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return AIRACIdentifier(n.AIRAC).Value()
+}
+
+// Scan implements sql.Scanner.
+func (n *NullAIRAC) Scan(src interface{}) error {
+	if src == nil {
+		n.AIRAC, n.Valid = 0, false
+		return nil
+	}
+	var id AIRACIdentifier
+	if err := id.Scan(src); err != nil {
+		return err
+	}
+	n.AIRAC, n.Valid = AIRAC(id), true
+	return nil
+}
+
+// GormDataType returns the generic GORM data type of NullAIRAC columns.
+func (NullAIRAC) GormDataType() string {
+	return "string"
+}
+
+// static assert
+var (
+	_ driver.Valuer = AIRACIdentifier(0)
+	_ sql.Scanner   = (*AIRACIdentifier)(nil)
+	_ driver.Valuer = AIRACSerial(0)
+	_ sql.Scanner   = (*AIRACSerial)(nil)
+	_ driver.Valuer = NullAIRAC{}
+	_ sql.Scanner   = (*NullAIRAC)(nil)
+)
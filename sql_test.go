@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+)
+
+func TestSQL(t *testing.T) {
+	t.Parallel()
+
+	a := AIRACIdentifier(FromStringMust("2313"))
+
+	if v, err := a.Value(); err != nil || v != "2313" {
+		t.Errorf("Want value 2313, got %v (%v)", v, err)
+	}
+	for _, src := range []interface{}{"2313", []byte("2313")} {
+		var got AIRACIdentifier
+		if err := got.Scan(src); err != nil || got != a {
+			t.Errorf("Scan(%#v): want %s, got %s (%v)", src, a, got, err)
+		}
+	}
+	for _, src := range []interface{}{nil, "2314", int64(1604), 3.14} {
+		var got AIRACIdentifier
+		if err := got.Scan(src); err == nil {
+			t.Errorf("Scan(%#v) yields %s, but should have raised an error", src, got)
+		}
+	}
+	for _, serial := range []AIRAC{0, 3811} {
+		if v, err := AIRACIdentifier(serial).Value(); err == nil {
+			t.Errorf("Serial %d yields value %v, but should have raised an error", uint16(serial), v)
+		}
+	}
+}
+
+func TestSQLDefault(t *testing.T) {
+	t.Parallel()
+
+	// AIRAC is converted like its underlying type, i.e. stored as serial.
+	a := FromStringMust("2313")
+	if v, err := driver.DefaultParameterConverter.ConvertValue(a); err != nil || v != int64(1604) {
+		t.Errorf("Want value 1604, got %v (%v)", v, err)
+	}
+	if _, ok := interface{}(&a).(sql.Scanner); ok {
+		t.Error("AIRAC must not implement sql.Scanner")
+	}
+}
+
+func TestSQLSerial(t *testing.T) {
+	t.Parallel()
+
+	s := AIRACSerial(FromStringMust("2313"))
+
+	if v, err := s.Value(); err != nil || v != int64(1604) {
+		t.Errorf("Want value 1604, got %v (%v)", v, err)
+	}
+	for _, src := range []interface{}{int64(1604), "1604", []byte("1604")} {
+		var got AIRACSerial
+		if err := got.Scan(src); err != nil || got != s {
+			t.Errorf("Scan(%#v): want %s, got %s (%v)", src, s, got, err)
+		}
+	}
+	for _, src := range []interface{}{nil, int64(-1), int64(MaxSerial) + 1, "2313x", "65536", 3.14} {
+		var got AIRACSerial
+		if err := got.Scan(src); err == nil {
+			t.Errorf("Scan(%#v) yields %s, but should have raised an error", src, got)
+		}
+	}
+	if s.AIRAC().String() != "2313" || s.String() != "2313" {
+		t.Errorf("Want 2313, got %s", s)
+	}
+}
+
+func TestSQLNull(t *testing.T) {
+	t.Parallel()
+
+	var n NullAIRAC
+	if v, err := n.Value(); err != nil || v != nil {
+		t.Errorf("Want NULL, got %v (%v)", v, err)
+	}
+	if err := n.Scan("2313"); err != nil || !n.Valid || n.AIRAC.String() != "2313" {
+		t.Errorf("Want valid 2313, got %+v (%v)", n, err)
+	}
+	if v, err := n.Value(); err != nil || v != "2313" {
+		t.Errorf("Want value 2313, got %v (%v)", v, err)
+	}
+	if err := n.Scan(nil); err != nil || n.Valid || n.AIRAC != 0 {
+		t.Errorf("Want NULL, got %+v (%v)", n, err)
+	}
+	if err := n.Scan("nope"); err == nil {
+		t.Error("Scanning garbage should have raised an error")
+	}
+	if v, err := (NullAIRAC{Valid: true}).Value(); err == nil {
+		t.Errorf("Serial 0 yields value %v, but should have raised an error", v)
+	}
+}
+
+func TestGormDataType(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []interface{ GormDataType() string }{AIRACIdentifier(0), AIRACSerial(0), NullAIRAC{}} {
+		if v.GormDataType() == "" {
+			t.Errorf("%T: missing GORM data type", v)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"time"
+)
+
+// Status is the result of evaluating a StalenessPolicy.
+type Status int
+
+// The results of a StalenessPolicy evaluation in ascending severity.
+const (
+	StatusOK Status = iota
+	StatusWarn
+	StatusFail
+)
+
+// String returns the name of this status.
+func (s Status) String() string {
+	switch s {
+	case StatusOK:
+		return "OK"
+	case StatusWarn:
+		return "Warn"
+	case StatusFail:
+		return "Fail"
+	default:
+		return fmt.Sprintf("Status(%d)", int(s))
+	}
+}
+
+// StalenessPolicy defines how far the AIRAC cycle of a dataset may lag behind
+// the current cycle.
+type StalenessPolicy struct {
+	// MaxBehind is the number of cycles a dataset may lag behind without a
+	// warning.
+	MaxBehind int
+
+	// Grace is the period after a cycle becomes effective during which the
+	// previous cycle is still considered current.
+	Grace time.Duration
+
+	// FailBehind is the number of cycles behind at which the evaluation
+	// fails. Zero disables failing.
+	FailBehind int
+}
+
+// Evaluate evaluates the AIRAC cycle of a dataset at now. It returns the status
+// and a human-readable reason. Datasets of future cycles are OK.
+func (p StalenessPolicy) Evaluate(dataset AIRAC, now time.Time) (Status, string) {
+	current := FromDate(now.Add(-p.Grace))
+	behind := int(current) - int(dataset)
+
+	switch {
+	case behind < 0:
+		return StatusOK, fmt.Sprintf("dataset %s is %s ahead of current cycle %s", dataset, cycles(-behind), current)
+	case p.FailBehind > 0 && behind >= p.FailBehind:
+		return StatusFail, fmt.Sprintf("dataset %s is %s behind current cycle %s (fail at %d)", dataset, cycles(behind), current, p.FailBehind)
+	case behind > p.MaxBehind:
+		return StatusWarn, fmt.Sprintf("dataset %s is %s behind current cycle %s (max %d)", dataset, cycles(behind), current, p.MaxBehind)
+	case behind == 0:
+		return StatusOK, fmt.Sprintf("dataset %s is current", dataset)
+	default:
+		return StatusOK, fmt.Sprintf("dataset %s is %s behind current cycle %s (max %d)", dataset, cycles(behind), current, p.MaxBehind)
+	}
+}
+
+func cycles(n int) string {
+	if n == 1 {
+		return "1 cycle"
+	}
+	return fmt.Sprintf("%d cycles", n)
+}
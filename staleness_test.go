@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStalenessPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy := StalenessPolicy{MaxBehind: 1, Grace: 6 * time.Hour, FailBehind: 3}
+	now := FromStringMust("2313").Effective()
+
+	testt := []struct {
+		dataset string
+		now     time.Time
+		want    Status
+	}{
+		{"2313", now, StatusOK},
+		{"2401", now, StatusOK},
+		{"2312", now.Add(7 * time.Hour), StatusOK},
+		{"2311", now, StatusOK},
+		{"2311", now.Add(7 * time.Hour), StatusWarn},
+		{"2310", now, StatusWarn},
+		{"2310", now.Add(7 * time.Hour), StatusFail},
+		{"2309", now, StatusFail},
+	}
+
+	for _, tt := range testt {
+		got, reason := policy.Evaluate(FromStringMust(tt.dataset), tt.now)
+		if got != tt.want {
+			t.Errorf("Dataset %s at %s: want %s, got %s (%s)", tt.dataset, tt.now, tt.want, got, reason)
+		}
+		if reason == "" {
+			t.Errorf("Dataset %s at %s: missing reason", tt.dataset, tt.now)
+		}
+	}
+}
+
+func TestStalenessPolicyZero(t *testing.T) {
+	t.Parallel()
+
+	var policy StalenessPolicy
+	now := FromStringMust("2313").Effective()
+
+	if got, reason := policy.Evaluate(FromStringMust("2313"), now); got != StatusOK {
+		t.Errorf("Want %s, got %s (%s)", StatusOK, got, reason)
+	}
+	if got, reason := policy.Evaluate(FromStringMust("2201"), now); got != StatusWarn {
+		t.Errorf("Want %s, got %s (%s)", StatusWarn, got, reason)
+	}
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"crypto/sha1" // nolint:gosec // mandated by UUID version 5
+	"encoding/hex"
+	"fmt"
+)
+
+// UUID is a universally unique identifier as defined by RFC 9562.
+type UUID [16]byte
+
+// NamespaceAIRAC is the default namespace of the UUIDs of AIRAC cycles. It is
+// the version 5 UUID of the URL "https://github.com/jwkohnen/airac" in the URL
+// namespace.
+//
+// nolint:gochecknoglobals
+var NamespaceAIRAC = UUID{0x17, 0x50, 0xb0, 0xb2, 0x41, 0xdd, 0x5a, 0xbc, 0xbf, 0xbb, 0x17, 0x9b, 0x4b, 0xcb, 0x8c, 0x67}
+
+// UUID returns the deterministic version 5 UUID of this AIRAC cycle in
+// namespace, e.g. as a stable key of cycle-scoped resources. The name of the
+// cycle is its identifier and effective date "YYOO@YYYY-MM-DD", which is
+// unambiguous across centuries.
+func (a AIRAC) UUID(namespace UUID) UUID {
+	h := sha1.New() // nolint:gosec // mandated by UUID version 5
+	h.Write(namespace[:])
+	h.Write([]byte(a.String() + "@" + a.Effective().Format(format)))
+
+	var u UUID
+	copy(u[:], h.Sum(nil))
+	u[6] = u[6]&0x0f | 0x50 // version 5
+	u[8] = u[8]&0x3f | 0x80 // variant RFC 9562
+	return u
+}
+
+// String returns the canonical representation of this UUID, e.g.
+// "900d6d17-5939-5f75-8da0-bf8f25dd80c7".
+func (u UUID) String() string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+	return string(buf[:])
+}
+
+// MarshalText implements encoding.TextMarshaler with the representation of
+// String.
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for the representation of
+// String.
+func (u *UUID) UnmarshalText(text []byte) error {
+	if len(text) != 36 || text[8] != '-' || text[13] != '-' || text[18] != '-' || text[23] != '-' {
+		return fmt.Errorf("illegal UUID %q", text)
+	}
+
+	var (
+		parsed UUID
+		groups = [][2]int{{0, 8}, {9, 13}, {14, 18}, {19, 23}, {24, 36}}
+		i      int
+	)
+	for _, g := range groups {
+		n, err := hex.Decode(parsed[i:], text[g[0]:g[1]])
+		if err != nil {
+			return fmt.Errorf("illegal UUID %q", text)
+		}
+		i += n
+	}
+	*u = parsed
+	return nil
+}
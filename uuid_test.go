@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestUUID(t *testing.T) {
+	t.Parallel()
+
+	// NamespaceDNS of RFC 9562
+	dns := UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	testt := []struct {
+		id        string
+		namespace UUID
+		want      string
+	}{
+		{"2313", NamespaceAIRAC, "900d6d17-5939-5f75-8da0-bf8f25dd80c7"},
+		{"2401", dns, "6f7ac9a3-85b6-574f-b4d1-0ac9078646f4"},
+	}
+	for _, tt := range testt {
+		got := FromStringMust(tt.id).UUID(tt.namespace)
+		if got.String() != tt.want {
+			t.Errorf("%s: want %s, got %s", tt.id, tt.want, got)
+		}
+
+		var back UUID
+		if err := back.UnmarshalText([]byte(tt.want)); err != nil || back != got {
+			t.Errorf("%s: want %s, got %s (%v)", tt.id, got, back, err)
+		}
+	}
+
+	seen := make(map[UUID]AIRAC)
+	for a := AIRAC(0); a <= AIRAC(MaxSerial); a++ {
+		u := a.UUID(NamespaceAIRAC)
+		if prev, ok := seen[u]; ok {
+			t.Fatalf("%s and %s share UUID %s", prev.LongString(), a.LongString(), u)
+		}
+		seen[u] = a
+	}
+}
+
+func TestUUIDUnmarshalTextIllegal(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{
+		"",
+		"900d6d17-5939-5f75-8da0-bf8f25dd80c",
+		"900d6d17x5939-5f75-8da0-bf8f25dd80c7",
+		"900d6d17-5939-5f75-8da0-bf8f25dd80cg",
+	} {
+		var u UUID
+		if err := u.UnmarshalText([]byte(s)); err == nil {
+			t.Errorf("%q yields %s, but should have raised an error", s, u)
+		}
+	}
+}
+
+func ExampleAIRAC_UUID() {
+	fmt.Println(FromStringMust("2313").UUID(NamespaceAIRAC))
+	// Output: 900d6d17-5939-5f75-8da0-bf8f25dd80c7
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"time"
+)
+
+// Violation is a violated invariant found by Verify.
+type Violation struct {
+	AIRAC     AIRAC
+	Invariant string
+	Detail    string
+}
+
+// String returns a human-readable description of this violation.
+func (v Violation) String() string {
+	return fmt.Sprintf("%s (serial %d): %s: %s", v.AIRAC, uint16(v.AIRAC), v.Invariant, v.Detail)
+}
+
+// Report is the result of Verify.
+type Report struct {
+	// Checked is the number of cycles checked.
+	Checked int
+
+	// Violations are the violated invariants in chronological order.
+	Violations []Violation
+}
+
+// OK reports whether no invariant was violated.
+func (r Report) OK() bool {
+	return len(r.Violations) == 0
+}
+
+// Verify checks the invariants of this package for all cycles from serial 0
+// until MaxSerial and returns a report:
+//
+//   - effective dates are 28 days apart,
+//   - effective dates are Thursdays at 00:00 UTC,
+//   - FromDate yields the cycle for its effective date and the previous cycle
+//     for the instant before,
+//   - ordinals start with 1 in each year, increase by 1 and do not exceed 14,
+//   - identifiers within IdentifierRange round trip through String and
+//     FromString.
+//
+// Verify is meant for acceptance tests of systems that embed this package.
+func Verify() Report {
+	var r Report
+	violate := func(a AIRAC, invariant, format string, args ...interface{}) {
+		r.Violations = append(r.Violations, Violation{AIRAC: a, Invariant: invariant, Detail: fmt.Sprintf(format, args...)})
+	}
+
+	for a := AIRAC(0); a <= AIRAC(MaxSerial); a++ {
+		r.Checked++
+		effective := a.Effective()
+
+		if d := (a + 1).Effective().Sub(effective); d != cycleDuration {
+			violate(a, "period", "want 28 days until next cycle, got %s", d)
+		}
+		if wd := effective.Weekday(); wd != time.Thursday {
+			violate(a, "weekday", "want %s, got %s", time.Thursday, wd)
+		}
+		if h, m, s := effective.Clock(); h != 0 || m != 0 || s != 0 || effective.Nanosecond() != 0 || effective.Location() != time.UTC {
+			violate(a, "midnight", "want 00:00 UTC, got %s", effective)
+		}
+
+		if got := FromDate(effective); got != a {
+			violate(a, "from date", "FromDate(%s) yields %s", effective.Format(time.RFC3339Nano), got)
+		}
+		if got := FromDate(effective.Add(-1)); a > 0 && got != a-1 {
+			violate(a, "from date", "FromDate(%s) yields %s", effective.Add(-1).Format(time.RFC3339Nano), got)
+		}
+
+		switch ordinal, prev := a.Ordinal(), a-1; {
+		case ordinal < 1 || ordinal > 14:
+			violate(a, "ordinal", "ordinal %d out of range", ordinal)
+		case a > 0 && prev.Year() != a.Year() && ordinal != 1:
+			violate(a, "ordinal", "first cycle of %d has ordinal %d", a.Year(), ordinal)
+		case a > 0 && prev.Year() == a.Year() && ordinal != prev.Ordinal()+1:
+			violate(a, "ordinal", "ordinal %d does not succeed %d", ordinal, prev.Ordinal())
+		}
+
+		if IdentifierRange.Contains(a) {
+			if got, err := FromString(a.String()); err != nil || got != a {
+				violate(a, "round trip", "FromString(%q) yields %d (%v)", a.String(), got, err)
+			}
+		}
+	}
+
+	return r
+}
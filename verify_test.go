@@ -0,0 +1,36 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"testing"
+)
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	r := Verify()
+	if r.Checked != int(MaxSerial)+1 {
+		t.Errorf("Want %d checked cycles, got %d", int(MaxSerial)+1, r.Checked)
+	}
+	for _, v := range r.Violations {
+		t.Error(v)
+	}
+	if !r.OK() {
+		t.Errorf("Want no violations, got %d", len(r.Violations))
+	}
+}
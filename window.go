@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"math"
+)
+
+// NextN returns the n cycles following this AIRAC cycle in chronological
+// order. The result is truncated at the upper limit of the AIRAC type.
+func (a AIRAC) NextN(n int) []AIRAC {
+	return Window(a, 0, n)[1:]
+}
+
+// UpcomingN returns the n cycles following the current cycle in chronological
+// order, e.g. the cycles to pre-fetch data for.
+func UpcomingN(n int) []AIRAC {
+	return Current().NextN(n)
+}
+
+// Window returns the cycles from before cycles preceding center until after
+// cycles following center in chronological order, including center. Negative
+// numbers are treated as zero and the result is truncated at the limits of the
+// AIRAC type.
+func Window(center AIRAC, before, after int) []AIRAC {
+	if before < 0 {
+		before = 0
+	}
+	if after < 0 {
+		after = 0
+	}
+	if before > int(center) {
+		before = int(center)
+	}
+	if max := math.MaxUint16 - int(center); after > max {
+		after = max
+	}
+
+	first := center - AIRAC(before)
+	window := make([]AIRAC, before+after+1)
+	for i := range window {
+		window[i] = first + AIRAC(i)
+	}
+	return window
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestWindow(t *testing.T) {
+	t.Parallel()
+
+	center := FromStringMust("2401")
+	testt := []struct {
+		center        AIRAC
+		before, after int
+		want          string
+	}{
+		{center, 1, 2, "[2313 2401 2402 2403]"},
+		{center, 0, 0, "[2401]"},
+		{center, -1, -1, "[2401]"},
+		{center, 2, 0, "[2312 2313 2401]"},
+		{1, 5, 0, "[0101 0102]"},
+	}
+
+	for _, tt := range testt {
+		if got := fmt.Sprint(Window(tt.center, tt.before, tt.after)); got != tt.want {
+			t.Errorf("Window(%s, %d, %d): want %s, got %s", tt.center, tt.before, tt.after, tt.want, got)
+		}
+	}
+
+	if got := Window(math.MaxUint16-1, 0, 5); len(got) != 2 || got[1] != math.MaxUint16 {
+		t.Errorf("Window at upper limit: got %v", []uint16{uint16(got[0]), uint16(got[len(got)-1])})
+	}
+}
+
+func TestNextN(t *testing.T) {
+	t.Parallel()
+
+	if got, want := fmt.Sprint(FromStringMust("2313").NextN(2)), "[2401 2402]"; got != want {
+		t.Errorf("Want %s, got %s", want, got)
+	}
+	if got := FromStringMust("2313").NextN(0); len(got) != 0 {
+		t.Errorf("Want no cycles, got %v", got)
+	}
+	if got := AIRAC(math.MaxUint16).NextN(1); len(got) != 0 {
+		t.Errorf("Want no cycles beyond the upper limit, got %d", len(got))
+	}
+
+	before := Current()
+	got := UpcomingN(2)
+	if len(got) != 2 || (got[0] != before+1 && got[0] != before+2) || got[1] != got[0]+1 {
+		t.Errorf("Unexpected upcoming cycles %v after %s", got, before)
+	}
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"time"
+)
+
+// CyclesInYear returns the number of AIRAC cycles that become effective in
+// year, i.e. 13 or, in rare cases like 2020, 14.
+func CyclesInYear(year int) int {
+	return FromDate(time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)).Ordinal()
+}
+
+// RemainingInYear returns the number of AIRAC cycles that become effective
+// after this cycle in the same year.
+func (a AIRAC) RemainingInYear() int {
+	return CyclesInYear(a.Year()) - a.Ordinal()
+}
+
+// Age returns the number of whole cycles between this AIRAC cycle and the
+// cycle effective at now. The age of a future cycle is negative.
+func (a AIRAC) Age(now time.Time) int {
+	return int(FromDate(now)) - int(a)
+}
+
+// IsYearBoundaryCycle reports whether the validity of this AIRAC cycle
+// touches two calendar years, i.e. whether the cycle becomes effective in one
+// year and expires in the next.
+func (a AIRAC) IsYearBoundaryCycle() bool {
+	return len(a.Years()) == 2
+}
+
+// Years returns the calendar years the validity of this AIRAC cycle touches in
+// ascending order, i.e. the year of the effective date and, for year boundary
+// cycles, the following year.
+func (a AIRAC) Years() []int {
+	first, last := a.Effective().Year(), (a + 1).Effective().Add(-1).Year()
+	if first == last {
+		return []int{first}
+	}
+	return []int{first, last}
+}
+
+// DaysInYear returns the number of days of the validity of this AIRAC cycle
+// that lie in year, e.g. to apportion a year boundary cycle to both years.
+func (a AIRAC) DaysInYear(year int) int {
+	begin, end := a.Effective(), (a + 1).Effective()
+	newYear := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	if newYear.After(begin) {
+		begin = newYear
+	}
+	if nextYear := newYear.AddDate(1, 0, 0); nextYear.Before(end) {
+		end = nextYear
+	}
+	if !end.After(begin) {
+		return 0
+	}
+	return int(end.Sub(begin) / (24 * time.Hour))
+}
+
+// YearBoundaryCycle returns the AIRAC cycle that is effective on January 1st
+// of year. It is a year boundary cycle unless it becomes effective on that
+// very day.
+func YearBoundaryCycle(year int) AIRAC {
+	return FromDate(time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC))
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2020 Johannes Kohnen <jwkohnen-github@ko-sys.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package airac
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCyclesInYear(t *testing.T) {
+	t.Parallel()
+
+	for year, want := range map[int]int{1998: 14, 2019: 13, 2020: 14, 2021: 13, 2023: 13} {
+		if got := CyclesInYear(year); got != want {
+			t.Errorf("%d: want %d cycles, got %d", year, want, got)
+		}
+	}
+}
+
+func TestRemainingInYear(t *testing.T) {
+	t.Parallel()
+
+	for id, want := range map[string]int{"2001": 13, "2013": 1, "2014": 0, "2301": 12, "2313": 0} {
+		if got := FromStringMust(id).RemainingInYear(); got != want {
+			t.Errorf("%s: want %d remaining cycles, got %d", id, want, got)
+		}
+	}
+}
+
+func TestAge(t *testing.T) {
+	t.Parallel()
+
+	now := FromStringMust("2401").Effective().Add(27 * 24 * time.Hour)
+	for id, want := range map[string]int{"2401": 0, "2313": 1, "2301": 13, "2402": -1} {
+		if got := FromStringMust(id).Age(now); got != want {
+			t.Errorf("%s: want age %d, got %d", id, want, got)
+		}
+	}
+}
+
+func TestYearBoundaryCycle(t *testing.T) {
+	t.Parallel()
+
+	testt := []struct {
+		id       string
+		boundary bool
+		years    []int
+		days     []int
+	}{
+		{"2312", false, []int{2023}, []int{28}},
+		{"2313", true, []int{2023, 2024}, []int{4, 24}},
+		{"2001", false, []int{2020}, []int{28}},
+		{"2014", true, []int{2020, 2021}, []int{1, 27}},
+	}
+	for _, tt := range testt {
+		a := FromStringMust(tt.id)
+		if got := a.IsYearBoundaryCycle(); got != tt.boundary {
+			t.Errorf("%s: want boundary %t, got %t", tt.id, tt.boundary, got)
+		}
+		years := a.Years()
+		if len(years) != len(tt.years) {
+			t.Errorf("%s: want years %v, got %v", tt.id, tt.years, years)
+			continue
+		}
+		for i := range years {
+			if years[i] != tt.years[i] {
+				t.Errorf("%s: want years %v, got %v", tt.id, tt.years, years)
+			}
+			if got := a.DaysInYear(years[i]); got != tt.days[i] {
+				t.Errorf("%s: want %d days in %d, got %d", tt.id, tt.days[i], years[i], got)
+			}
+		}
+		if got := a.DaysInYear(years[0] - 1); got != 0 {
+			t.Errorf("%s: want 0 days in %d, got %d", tt.id, years[0]-1, got)
+		}
+	}
+
+	for year, want := range map[int]string{2024: "2313", 2021: "2014", 2020: "1913"} {
+		if got := YearBoundaryCycle(year); got.String() != want {
+			t.Errorf("%d: want %s, got %s", year, want, got)
+		}
+	}
+}